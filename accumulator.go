@@ -0,0 +1,179 @@
+package udecimal
+
+import "math/big"
+
+// Accumulator sums a large number of decimals with a fixed number of digits after the decimal point.
+//
+// Unlike repeated calls to [Decimal.Add], it doesn't rescale the total and build a new Decimal for every operand.
+// The total is stored in 256 bits, so it doesn't allocate until the total has more than 77 digits,
+// at which point it switches to *big.Int until [Accumulator.Reset] is called.
+//
+// The zero value is an Accumulator with 0 digits after the decimal point, use [NewAccumulator] to choose the precision.
+// An Accumulator is not safe for concurrent use and must not be copied after first use.
+//
+// Example:
+//
+//	acc := udecimal.NewAccumulator(2)
+//	for _, t := range trades {
+//		acc.AddProduct(t.Price, t.Quantity)
+//	}
+//
+//	notional := acc.Result()
+type Accumulator struct {
+	// |total| * 10^prec
+	sum u256
+
+	// total * 10^prec, only used when it doesn't fit in sum
+	big *big.Int
+
+	neg  bool
+	prec uint8
+}
+
+// NewAccumulator returns an empty Accumulator which keeps prec digits after the decimal point.
+// Values of prec greater than 19 are treated as 19.
+func NewAccumulator(prec uint8) Accumulator {
+	return Accumulator{prec: min(prec, maxPrec)}
+}
+
+// Add adds d to the total.
+// If d has more digits after the decimal point than the precision of a, the extra digits are truncated.
+func (a *Accumulator) Add(d Decimal) {
+	a.add(d.neg, d.coef, d.prec)
+}
+
+// Sub subtracts d from the total.
+// If d has more digits after the decimal point than the precision of a, the extra digits are truncated.
+func (a *Accumulator) Sub(d Decimal) {
+	a.add(!d.neg, d.coef, d.prec)
+}
+
+// AddProduct adds d * e to the total, e.g. price * quantity or the terms of a dot product.
+// The product is computed exactly and then truncated to the precision of a,
+// so the result can differ from summing d.Mul(e) when d.Mul(e) would have more than 19 digits after the decimal point.
+func (a *Accumulator) AddProduct(d, e Decimal) {
+	neg := d.neg != e.neg
+
+	if !d.coef.overflow() && !e.coef.overflow() {
+		a.addU256(neg, d.coef.u128.MulToU256(e.coef.u128), d.prec+e.prec)
+		return
+	}
+
+	a.addBig(neg, new(big.Int).Mul(d.coef.GetBig(), e.coef.GetBig()), d.prec+e.prec)
+}
+
+// Result returns the total.
+// It doesn't allocate when the coefficient of the total fits in 128 bits.
+func (a *Accumulator) Result() Decimal {
+	if a.big != nil {
+		return newDecimal(a.big.Sign() < 0, compactBint(new(big.Int).Abs(a.big)), a.prec)
+	}
+
+	if a.sum.carry.IsZero() {
+		return newDecimal(a.neg, bintFromU128(u128FromHiLo(a.sum.hi, a.sum.lo)), a.prec)
+	}
+
+	return newDecimal(a.neg, bintFromBigInt(a.sum.toBigInt()), a.prec)
+}
+
+// Reset sets the total to zero, keeping the precision.
+func (a *Accumulator) Reset() {
+	*a = Accumulator{prec: a.prec}
+}
+
+func (a *Accumulator) add(neg bool, coef bint, prec uint8) {
+	if coef.overflow() {
+		a.addBig(neg, coef.GetBig(), prec)
+		return
+	}
+
+	a.addU256(neg, u256{hi: coef.u128.hi, lo: coef.u128.lo}, prec)
+}
+
+// addU256 adds (-1)^neg * v / 10^prec to the total
+func (a *Accumulator) addU256(neg bool, v u256, prec uint8) {
+	if a.big == nil {
+		if scaled, err := a.rescale(v, prec); err == nil && a.addScaled(neg, scaled) {
+			return
+		}
+	}
+
+	// overflow, fallback to big.Int
+	a.addBig(neg, v.toBigInt(), prec)
+}
+
+// rescale returns v / 10^prec as a number with a.prec digits after the decimal point, extra digits are truncated.
+func (a *Accumulator) rescale(v u256, prec uint8) (u256, error) {
+	if prec < a.prec {
+		if v.carry.IsZero() {
+			// a u128 multiplied by 10^19 at most always fits in 256 bits
+			return u128FromHiLo(v.hi, v.lo).MulToU256(pow10[a.prec-prec]), nil
+		}
+
+		return v.mul128(pow10[a.prec-prec])
+	}
+
+	// prec <= 38, so 2 divisions at most
+	for ; prec > a.prec+maxPrec; prec -= maxPrec {
+		v, _ = v.quoRem64(pow10[maxPrec].lo)
+	}
+
+	if prec > a.prec {
+		v, _ = v.quoRem64(pow10[prec-a.prec].lo)
+	}
+
+	return v, nil
+}
+
+// addScaled adds (-1)^neg * v to a.sum.
+// Returns false if the result doesn't fit in 256 bits, a is unchanged in that case.
+func (a *Accumulator) addScaled(neg bool, v u256) bool {
+	if v.isZero() {
+		return true
+	}
+
+	if a.sum.isZero() || a.neg == neg {
+		sum, err := a.sum.add(v)
+		if err != nil {
+			return false
+		}
+
+		a.sum, a.neg = sum, neg
+		return true
+	}
+
+	// different sign, subtract the smaller magnitude from the larger one
+	switch a.sum.cmp(v) {
+	case 1:
+		a.sum = a.sum.sub(v)
+	case -1:
+		a.sum, a.neg = v.sub(a.sum), neg
+	default:
+		a.sum, a.neg = u256{}, false
+	}
+
+	return true
+}
+
+// addBig adds (-1)^neg * v / 10^prec to the total, using *big.Int.
+// v is modified.
+func (a *Accumulator) addBig(neg bool, v *big.Int, prec uint8) {
+	if prec > a.prec {
+		v.Quo(v, pow10[prec-a.prec].ToBigInt())
+	} else if prec < a.prec {
+		v.Mul(v, pow10[a.prec-prec].ToBigInt())
+	}
+
+	if neg {
+		v.Neg(v)
+	}
+
+	if a.big == nil {
+		a.big = a.sum.toBigInt()
+		if a.neg {
+			a.big.Neg(a.big)
+		}
+	}
+
+	a.big.Add(a.big, v)
+}
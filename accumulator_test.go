@@ -0,0 +1,168 @@
+package udecimal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccumulator(t *testing.T) {
+	type accOp struct {
+		op   byte
+		a, b string
+	}
+
+	testcases := []struct {
+		name string
+		prec uint8
+		ops  []accOp
+		want string
+	}{
+		{"empty", 2, nil, "0"},
+		{"add", 2, []accOp{{'+', "1.23", ""}, {'+', "4.5", ""}, {'-', "0.73", ""}}, "5"},
+		{"truncate", 2, []accOp{{'+', "1.239", ""}, {'+', "0.001", ""}}, "1.23"},
+		{"truncate negative", 2, []accOp{{'-', "1.239", ""}}, "-1.23"},
+		{"zero precision", 0, []accOp{{'+', "1.9", ""}, {'+', "1.9", ""}}, "2"},
+		{"precision greater than 19", 30, []accOp{{'+', "0.1234567890123456789", ""}}, "0.1234567890123456789"},
+		{"sign change", 2, []accOp{{'+', "1", ""}, {'-', "3", ""}, {'+', "2", ""}}, "0"},
+		{"sign change", 2, []accOp{{'+', "1", ""}, {'-', "3", ""}, {'+', "1.5", ""}}, "-0.5"},
+		{"sign change", 2, []accOp{{'-', "1", ""}, {'+', "3", ""}}, "2"},
+		{"zero", 2, []accOp{{'-', "0", ""}, {'*', "0", "-1"}}, "0"},
+		{"product", 19, []accOp{{'*', "1.2345", "6.789"}}, "8.3810205"},
+		{"product", 19, []accOp{{'*', "-0.1234567890123456789", "0.9876543210987654321"}}, "-0.1219326311370217952"},
+		{"product", 2, []accOp{{'*', "-0.1234567890123456789", "0.9876543210987654321"}}, "-0.12"},
+		{"dot product", 2, []accOp{{'*', "1.5", "2"}, {'*', "-0.5", "4"}, {'*', "100.25", "0.5"}}, "51.12"},
+		{"more than 128 bits", 0, []accOp{{'+', maxU128Str, ""}, {'+', maxU128Str, ""}}, "680564733841876926926749214863536422910"},
+		{"more than 128 bits", 0, []accOp{{'+', maxU128Str, ""}, {'+', maxU128Str, ""}, {'-', maxU128Str, ""}}, maxU128Str},
+		{"more than 256 bits", 0, []accOp{{'+', "9e76", ""}, {'+', "9e76", ""}}, "18" + strings.Repeat("0", 76)},
+		{"more than 256 bits", 0, []accOp{{'+', "9e76", ""}, {'+', "9e76", ""}, {'-', "9e76", ""}, {'-', "9e76", ""}, {'+', "1.5", ""}}, "1"},
+		{"more than 256 bits", 0, []accOp{{'-', "9e76", ""}, {'-', "9e76", ""}, {'+', "1", ""}}, "-179" + strings.Repeat("9", 75)},
+		{"more than 256 bits after scaling", 19, []accOp{{'*', "1e38", "1e38"}, {'+', "0.5", ""}}, "1" + strings.Repeat("0", 76) + ".5"},
+		{"big.Int operand", 0, []accOp{{'+', "340282366920938463463374607431768211456", ""}, {'+', "1", ""}}, "340282366920938463463374607431768211457"},
+		{"big.Int operand", 1, []accOp{{'+', "1", ""}, {'-', "34028236692093846346337460743176821145.65", ""}}, "-34028236692093846346337460743176821144.6"},
+		{"big.Int product", 0, []accOp{{'*', "340282366920938463463374607431768211456", "-2"}}, "-680564733841876926926749214863536422912"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %d", tc.name, tc.prec), func(t *testing.T) {
+			acc := NewAccumulator(tc.prec)
+
+			for _, op := range tc.ops {
+				switch op.op {
+				case '+':
+					acc.Add(MustParse(op.a))
+				case '-':
+					acc.Sub(MustParse(op.a))
+				case '*':
+					acc.AddProduct(MustParse(op.a), MustParse(op.b))
+				}
+			}
+
+			got := acc.Result()
+			require.Equal(t, tc.want, got.String())
+			require.Equal(t, got.coef.GetBig().BitLen() > 128, got.coef.overflow())
+		})
+	}
+}
+
+func TestAccumulatorReset(t *testing.T) {
+	acc := NewAccumulator(2)
+	acc.Add(MustParse("9e76"))
+	acc.Add(MustParse("9e76"))
+	acc.Reset()
+	acc.Add(MustParse("1.239"))
+
+	require.Equal(t, "1.23", acc.Result().String())
+
+	// the zero value keeps no digits after the decimal point
+	var zero Accumulator
+	zero.Add(MustParse("1.5"))
+	require.Equal(t, "1", zero.Result().String())
+}
+
+func TestAccumulatorSum(t *testing.T) {
+	values := []string{
+		"1.23", "-4.5", "0.0000000000000000001", "-1234567890123456789.1234567890123456789", maxU128Str,
+		"99999999999999999999.9999999999999999999", "-0.5", "340282366920938463463374607431768211456",
+	}
+
+	acc := NewAccumulator(19)
+	want := Zero
+
+	for i := 0; i < 100; i++ {
+		for _, v := range values {
+			d := MustParse(v)
+			acc.Add(d)
+			acc.AddProduct(d, MustParse("-1.5"))
+			want = want.Add(d).Add(d.Mul(MustParse("-1.5")))
+		}
+
+		require.True(t, want.Equal(acc.Result()), "want %s, got %s", want, acc.Result())
+	}
+}
+
+func TestAccumulatorNoAlloc(t *testing.T) {
+	price := MustParse("12345.6789")
+	qty := MustParse("-0.001")
+	acc := NewAccumulator(19)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		acc.Add(price)
+		acc.Sub(qty)
+		acc.AddProduct(price, qty)
+		_ = acc.Result()
+	})
+	require.Equal(t, float64(0), allocs)
+
+	// the total doesn't fit in 128 bits, but still fits in 256 bits
+	big := NewAccumulator(0)
+	v := MustParse(maxU128Str)
+
+	allocs = testing.AllocsPerRun(100, func() {
+		big.Add(v)
+	})
+	require.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkAccumulator(b *testing.B) {
+	prices := []Decimal{MustParse("12345.6789"), MustParse("0.001"), MustParse("-98.76"), MustParse("1")}
+	qty := MustParse("3.5")
+
+	b.Run("Add", func(b *testing.B) {
+		acc := NewAccumulator(19)
+		for i := range b.N {
+			acc.Add(prices[i%len(prices)])
+		}
+
+		_ = acc.Result()
+	})
+
+	b.Run("AddProduct", func(b *testing.B) {
+		acc := NewAccumulator(19)
+		for i := range b.N {
+			acc.AddProduct(prices[i%len(prices)], qty)
+		}
+
+		_ = acc.Result()
+	})
+
+	b.Run("Decimal.Add", func(b *testing.B) {
+		sum := Zero
+		for i := range b.N {
+			sum = sum.Add(prices[i%len(prices)])
+		}
+
+		_ = sum
+	})
+
+	b.Run("Decimal.Mul", func(b *testing.B) {
+		sum := Zero
+		for i := range b.N {
+			sum = sum.Add(prices[i%len(prices)].Mul(qty))
+		}
+
+		_ = sum
+	})
+}
@@ -0,0 +1,168 @@
+package udecimal
+
+import (
+	"slices"
+)
+
+// Allocate splits d into len(ratios) parts proportionally to ratios, each part having at most prec digits
+// after the decimal point. The parts always sum up exactly to d.
+//
+// Each part is first truncated to prec digits, then the leftover smallest units (10^-prec) are distributed
+// one by one to the parts with the largest truncated remainders (largest remainder method).
+// Ties are broken in favour of the part that comes first.
+// For negative d, the parts are negative and the leftover units are distributed in the same way.
+//
+// Returns error if:
+//   - prec > 19 or d can't be represented exactly with prec digits after the decimal point ([ErrPrecOutOfRange])
+//   - ratios is empty, contains a negative value or sums up to zero ([ErrInvalidRatios])
+//
+// Example:
+//
+//	Allocate(100, 2, 1, 1, 1) = [33.34, 33.33, 33.33]
+//	Allocate(0.05, 2, 3, 7) = [0.02, 0.03]
+//	Allocate(-10, 0, 1, 2) = [-3, -7]
+func (d Decimal) Allocate(prec uint8, ratios ...Decimal) ([]Decimal, error) {
+	d, err := d.exactPrec(prec)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ratios) == 0 {
+		return nil, ErrInvalidRatios
+	}
+
+	total := Zero
+	for _, r := range ratios {
+		if r.neg {
+			return nil, ErrInvalidRatios
+		}
+
+		total = total.Add(r)
+	}
+
+	if total.IsZero() {
+		return nil, ErrInvalidRatios
+	}
+
+	// work with the number of smallest units, e.g. 100.00 = 10000 units of 0.01
+	units := d.units(prec)
+
+	var (
+		parts = make([]Decimal, len(ratios))
+		rems  = make([]Decimal, len(ratios))
+		left  = units
+	)
+
+	// parts[i] = units * ratios[i] / total, rems[i] is the truncated remainder.
+	// All remainders have the same divisor, so they can be compared directly.
+	for i, r := range ratios {
+		// units has no digits after the decimal point, so the product is exact
+		q, rem, err := units.mul(r, maxPrec).QuoRem(total)
+		if err != nil {
+			return nil, err
+		}
+
+		parts[i], rems[i] = q, rem
+		left = left.Sub(q)
+	}
+
+	// left < len(ratios) units are left over, give them to the parts with the largest remainders
+	if !left.IsZero() {
+		order := make([]int, len(ratios))
+		for i := range order {
+			order[i] = i
+		}
+
+		slices.SortStableFunc(order, func(i, j int) int {
+			return rems[j].Cmp(rems[i])
+		})
+
+		for _, i := range order {
+			if left.IsZero() {
+				break
+			}
+
+			parts[i] = parts[i].Add(One)
+			left = left.Sub(One)
+		}
+	}
+
+	for i := range parts {
+		parts[i] = newDecimal(d.neg, parts[i].coef, prec)
+	}
+
+	return parts, nil
+}
+
+// Split splits d into n parts as equal as possible, each part having at most prec digits
+// after the decimal point. The parts always sum up exactly to d.
+//
+// The leftover smallest units (10^-prec) are given to the first parts, one unit each,
+// which is the same as calling Allocate with n equal ratios.
+//
+// Returns error if:
+//   - prec > 19 or d can't be represented exactly with prec digits after the decimal point ([ErrPrecOutOfRange])
+//   - n < 1 ([ErrInvalidRatios])
+//
+// Example:
+//
+//	Split(100, 3, 2) = [33.34, 33.33, 33.33]
+//	Split(-0.05, 3, 2) = [-0.02, -0.02, -0.01]
+func (d Decimal) Split(n int, prec uint8) ([]Decimal, error) {
+	d, err := d.exactPrec(prec)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 1 {
+		return nil, ErrInvalidRatios
+	}
+
+	// q units for every part, the first r parts get one more unit
+	q, r, err := d.units(prec).QuoRem(MustFromInt64(int64(n), 0))
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]Decimal, n)
+	for i := range parts {
+		part := q
+		if r.Cmp(MustFromInt64(int64(i), 0)) > 0 {
+			part = part.Add(One)
+		}
+
+		parts[i] = newDecimal(d.neg, part.coef, prec)
+	}
+
+	return parts, nil
+}
+
+// exactPrec returns d with at most prec digits after the decimal point, dropping trailing zeros if needed.
+// Returns ErrPrecOutOfRange if prec > 19 or d can't be represented exactly with prec digits.
+func (d Decimal) exactPrec(prec uint8) (Decimal, error) {
+	if prec > maxPrec {
+		return Decimal{}, ErrPrecOutOfRange
+	}
+
+	if d.prec > prec {
+		t := d.Trunc(prec)
+		if !t.Equal(d) {
+			return Decimal{}, ErrPrecOutOfRange
+		}
+
+		d = t
+	}
+
+	return d, nil
+}
+
+// units returns |d| as a whole number of 10^-prec units, e.g. 1.23 with prec 4 is 12300.
+// prec must be greater than or equal to d.prec.
+func (d Decimal) units(prec uint8) Decimal {
+	coef := d.coef
+	if prec > d.prec {
+		coef = coef.Mul(bintFromU128(pow10[prec-d.prec]))
+	}
+
+	return newDecimal(false, coef, 0)
+}
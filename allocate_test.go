@@ -0,0 +1,189 @@
+package udecimal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocate(t *testing.T) {
+	testcases := []struct {
+		a       string
+		prec    uint8
+		ratios  []string
+		want    []string
+		wantErr error
+	}{
+		{"100", 2, []string{"1", "1", "1"}, []string{"33.34", "33.33", "33.33"}, nil},
+		{"100", 0, []string{"1", "1", "1"}, []string{"34", "33", "33"}, nil},
+		{"-100", 2, []string{"1", "1", "1"}, []string{"-33.34", "-33.33", "-33.33"}, nil},
+		{"0.05", 2, []string{"3", "7"}, []string{"0.02", "0.03"}, nil},
+		{"0.05", 2, []string{"0.3", "0.7"}, []string{"0.02", "0.03"}, nil},
+		{"-10", 0, []string{"1", "2"}, []string{"-3", "-7"}, nil},
+		{"10", 0, []string{"1", "1", "1", "1"}, []string{"3", "3", "2", "2"}, nil},
+		{"1", 2, []string{"0.2", "0.3", "0.5"}, []string{"0.2", "0.3", "0.5"}, nil},
+		{"100", 2, []string{"0.1", "0.2", "0.7"}, []string{"10", "20", "70"}, nil},
+		{"100", 2, []string{"70", "20", "10", "0"}, []string{"70", "20", "10", "0"}, nil},
+		{"0.01", 2, []string{"1", "1", "1"}, []string{"0.01", "0", "0"}, nil},
+		{"0.01", 2, []string{"1", "3", "1"}, []string{"0", "0.01", "0"}, nil},
+		{"0.02", 2, []string{"0", "1", "0", "1"}, []string{"0", "0.01", "0", "0.01"}, nil},
+		{"0", 2, []string{"1", "2"}, []string{"0", "0"}, nil},
+		{"1234.56", 2, []string{"1"}, []string{"1234.56"}, nil},
+		{"1234.56", 4, []string{"1", "1", "1"}, []string{"411.52", "411.52", "411.52"}, nil},
+		{"1", 19, []string{"1", "1", "1"}, []string{"0.3333333333333333334", "0.3333333333333333333", "0.3333333333333333333"}, nil},
+		{"1", 2, []string{"0.0000000000000000001", "0.0000000000000000002"}, []string{"0.33", "0.67"}, nil},
+		{
+			"123456789012345678901234567890123456789.12", 2,
+			[]string{"1", "1", "1"},
+			[]string{"41152263004115226300411522630041152263.04", "41152263004115226300411522630041152263.04", "41152263004115226300411522630041152263.04"},
+			nil,
+		},
+		{
+			"12345678901234567890123456789012345678901234567890", 3,
+			[]string{"1", "2"},
+			[]string{"4115226300411522630041152263004115226300411522630", "8230452600823045260082304526008230452600823045260"},
+			nil,
+		},
+		{"100", 2, nil, nil, ErrInvalidRatios},
+		{"100", 2, []string{"1", "-1"}, nil, ErrInvalidRatios},
+		{"100", 2, []string{"0", "0"}, nil, ErrInvalidRatios},
+		{"1.230", 2, []string{"1", "1"}, []string{"0.62", "0.61"}, nil},
+		{"1.234", 2, []string{"1", "1"}, nil, ErrPrecOutOfRange},
+		{"1", 20, []string{"1", "1"}, nil, ErrPrecOutOfRange},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("allocate(%s, %d, %s)", tc.a, tc.prec, strings.Join(tc.ratios, ",")), func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			ratios := make([]Decimal, len(tc.ratios))
+			for i, r := range tc.ratios {
+				ratios[i] = MustParse(r)
+			}
+
+			parts, err := a.Allocate(tc.prec, ratios...)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, parts, len(tc.want))
+
+			sum := Zero
+			for i, p := range parts {
+				require.Equal(t, tc.want[i], p.String())
+				require.LessOrEqual(t, p.Prec(), int(tc.prec))
+
+				sum = sum.Add(p)
+			}
+
+			// parts must sum up exactly to the original amount
+			require.Equal(t, a.String(), sum.String())
+		})
+	}
+}
+
+func TestAllocateDefaultPrecision(t *testing.T) {
+	// parsed before changing the default precision, which also limits Parse
+	r1, r2 := MustParse("0.00000000000049"), MustParse("0.00000000000051")
+
+	defer SetDefaultPrecision(maxPrec)
+
+	SetDefaultPrecision(10)
+
+	// prec is allowed up to 19 digits, whatever the default precision
+	parts, err := MustParse("1").Allocate(12, One, One, One)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.333333333334", "0.333333333333", "0.333333333333"}, decimalsToStrings(parts))
+
+	parts, err = MustParse("1").Split(3, 12)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.333333333334", "0.333333333333", "0.333333333333"}, decimalsToStrings(parts))
+
+	// the ratios have more digits than the default precision: 3 * 0.49 / 1 = 1.47 and 3 * 0.51 / 1 = 1.53,
+	// so the leftover unit goes to the second part
+	parts, err = MustParse("0.03").Allocate(2, r1, r2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.01", "0.02"}, decimalsToStrings(parts))
+}
+
+func decimalsToStrings(ds []Decimal) []string {
+	s := make([]string, len(ds))
+	for i, d := range ds {
+		s[i] = d.String()
+	}
+
+	return s
+}
+
+func TestSplit(t *testing.T) {
+	testcases := []struct {
+		a       string
+		n       int
+		prec    uint8
+		want    []string
+		wantErr error
+	}{
+		{"100", 3, 2, []string{"33.34", "33.33", "33.33"}, nil},
+		{"100", 3, 0, []string{"34", "33", "33"}, nil},
+		{"-0.05", 3, 2, []string{"-0.02", "-0.02", "-0.01"}, nil},
+		{"0.01", 3, 2, []string{"0.01", "0", "0"}, nil},
+		{"10", 4, 0, []string{"3", "3", "2", "2"}, nil},
+		{"10", 4, 1, []string{"2.5", "2.5", "2.5", "2.5"}, nil},
+		{"0", 2, 2, []string{"0", "0"}, nil},
+		{"1.23", 1, 2, []string{"1.23"}, nil},
+		{"1", 3, 19, []string{"0.3333333333333333334", "0.3333333333333333333", "0.3333333333333333333"}, nil},
+		{
+			"123456789012345678901234567890123456789.12", 3, 2,
+			[]string{"41152263004115226300411522630041152263.04", "41152263004115226300411522630041152263.04", "41152263004115226300411522630041152263.04"},
+			nil,
+		},
+		{
+			"12345678901234567890123456789012345678901234567891", 2, 0,
+			[]string{"6172839450617283945061728394506172839450617283946", "6172839450617283945061728394506172839450617283945"},
+			nil,
+		},
+		{"100", 0, 2, nil, ErrInvalidRatios},
+		{"100", -1, 2, nil, ErrInvalidRatios},
+		{"1.230", 2, 2, []string{"0.62", "0.61"}, nil},
+		{"1.234", 2, 2, nil, ErrPrecOutOfRange},
+		{"1", 2, 20, nil, ErrPrecOutOfRange},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("split(%s, %d, %d)", tc.a, tc.n, tc.prec), func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			parts, err := a.Split(tc.n, tc.prec)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, parts, len(tc.want))
+
+			sum := Zero
+			for i, p := range parts {
+				require.Equal(t, tc.want[i], p.String())
+
+				sum = sum.Add(p)
+			}
+
+			require.Equal(t, a.String(), sum.String())
+
+			// Split is the same as Allocate with equal ratios
+			ratios := make([]Decimal, tc.n)
+			for i := range ratios {
+				ratios[i] = One
+			}
+
+			allocated, err := a.Allocate(tc.prec, ratios...)
+			require.NoError(t, err)
+			require.Equal(t, parts, allocated)
+		})
+	}
+}
@@ -61,6 +61,17 @@ func bintFromBigInt(b *big.Int) bint {
 	return bint{bigInt: b}
 }
 
+// compactBint returns b as a bint, using u128 instead of *big.Int when b fits in 128 bits
+// so that subsequent operations can use the fast path
+func compactBint(b *big.Int) bint {
+	if b.BitLen() > 128 {
+		return bintFromBigInt(b)
+	}
+
+	hi := new(big.Int).Rsh(b, 64)
+	return bintFromU128(u128{hi: hi.Uint64(), lo: b.Uint64()})
+}
+
 func bintFromU128(u u128) bint {
 	return bint{u128: u}
 }
@@ -85,6 +96,14 @@ func (u bint) IsZero() bool {
 	return u.bigInt.Cmp(bigZero) == 0
 }
 
+func (u bint) isOdd() bool {
+	if !u.overflow() {
+		return u.u128.lo&1 == 1
+	}
+
+	return u.bigInt.Bit(0) == 1
+}
+
 func (u bint) Cmp(v bint) int {
 	if !u.overflow() && !v.overflow() {
 		return u.u128.Cmp(v.u128)
@@ -97,19 +116,41 @@ func errInvalidFormat(s []byte) error {
 	return fmt.Errorf("%w: can't parse '%s'", ErrInvalidFormat, s)
 }
 
-func parseBint(s []byte) (bool, bint, uint8, error) {
+func errInvalidParseMode(mode ParseMode) error {
+	return fmt.Errorf("invalid parse mode: %d. Make sure to use SetParseMode with a valid value", mode)
+}
+
+func (c Context) parseBint(s []byte) (bool, bint, uint8, error) {
 	if len(s) == 0 {
-		return false, bint{}, 0, ErrEmptyString
+		return false, bint{}, 0, &ParseError{Reason: ReasonEmptyString}
 	}
 
 	if len(s) > maxStrLen {
-		return false, bint{}, 0, ErrMaxStrLen
+		return false, bint{}, 0, &ParseError{Input: string(s), Offset: maxStrLen, Reason: ReasonTooLong}
 	}
 
+	neg, coef, prec, err := c.parseBintPlain(s)
+	if err != nil {
+		// scientific notation, e.g. 1.23e4 or 5E-3.
+		// Only checked when the plain format fails, to keep the common case fast.
+		if ePos := exponentIndex(s); ePos >= 0 {
+			neg, coef, prec, err = c.parseBintSci(s, ePos)
+		}
+
+		if err != nil {
+			return false, bint{}, 0, c.parseError(s, err)
+		}
+	}
+
+	return neg, coef, prec, nil
+}
+
+// parseBintPlain parses a number without exponent: [+-]d+[.d+]
+func (c Context) parseBintPlain(s []byte) (bool, bint, uint8, error) {
 	// if s has less than 41 characters, it can fit into u128
 	// 41 chars = maxLen(u128) + dot + sign = 39 + 1 + 1
 	if len(s) <= 41 {
-		neg, bint, prec, err := parseBintFromU128(s)
+		neg, bint, prec, err := c.parseBintFromU128(s)
 		if err == nil || err != errOverflow {
 			return neg, bint, prec, err
 		}
@@ -161,18 +202,24 @@ func parseBint(s []byte) (bool, bint, uint8, error) {
 		return false, bint{}, 0, errInvalidFormat(s)
 	default:
 		prec = vLen - pIndex - 1
-		switch defaultParseMode {
+		precLimit := int(c.prec())
+		switch c.ParseMode {
 		case ParseModeError:
-			if prec > int(defaultPrec) {
+			if prec > precLimit {
 				return false, bint{}, 0, ErrPrecOutOfRange
 			}
 		case ParseModeTrunc:
-			if prec > int(defaultPrec) {
-				value = value[:pIndex+1+int(defaultPrec)]
-				prec = int(defaultPrec)
+			if prec > precLimit {
+				// the dropped digits must be valid too, e.g. the exponent of scientific notation
+				if !isDigits(value[pIndex+1+precLimit:]) {
+					return false, bint{}, 0, errInvalidFormat(s)
+				}
+
+				value = value[:pIndex+1+precLimit]
+				prec = precLimit
 			}
 		default:
-			return false, bint{}, 0, fmt.Errorf("invalid parse mode: %d. Make sure to use SetParseMode with a valid value", defaultParseMode)
+			return false, bint{}, 0, errInvalidParseMode(c.ParseMode)
 		}
 
 		b := strings.Builder{}
@@ -205,7 +252,148 @@ func parseBint(s []byte) (bool, bint, uint8, error) {
 	return neg, bintFromBigInt(dValue), uint8(prec), nil
 }
 
-func parseBintFromU128(s []byte) (bool, bint, uint8, error) {
+// parseBintSci parses a number in scientific notation: [+-]d+[.d+](e|E)[+-]d+
+// The result is normalized into coef and prec, e.g. 1.23e4 = 12300 (prec 0) and 5E-3 = 0.005 (prec 3).
+// If the exponent pushes the number past c.Prec digits after the decimal point, trailing zeros are dropped first,
+// then c.ParseMode decides whether to return error or truncate the remaining digits.
+func (c Context) parseBintSci(s []byte, ePos int) (bool, bint, uint8, error) {
+	mantissa := s[:ePos]
+
+	var neg bool
+	if len(mantissa) > 0 && (mantissa[0] == '-' || mantissa[0] == '+') {
+		neg = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+
+	intPart, fracPart := mantissa, []byte(nil)
+	if pIndex := bytes.IndexByte(mantissa, '.'); pIndex >= 0 {
+		intPart, fracPart = mantissa[:pIndex], mantissa[pIndex+1:]
+
+		// prevent "123.e4"
+		if len(fracPart) == 0 {
+			return false, bint{}, 0, errInvalidFormat(s)
+		}
+	}
+
+	// prevent ".123e4", "e4" or "1.2.3e4"
+	if len(intPart) == 0 || !isDigits(intPart) || !isDigits(fracPart) {
+		return false, bint{}, 0, errInvalidFormat(s)
+	}
+
+	exp, ok := parseExponent(s[ePos+1:])
+	if !ok {
+		return false, bint{}, 0, errInvalidFormat(s)
+	}
+
+	// number = digits * 10^(-prec)
+	digits := make([]byte, 0, len(intPart)+len(fracPart))
+	digits = append(digits, intPart...)
+	digits = append(digits, fracPart...)
+	digits = bytes.TrimLeft(digits, "0")
+	prec := len(fracPart) - exp
+
+	if len(digits) == 0 {
+		return false, bint{}, 0, nil
+	}
+
+	// trailing zeros don't change the value, e.g. 1.0e-19 = 0.0000000000000000001
+	precLimit := int(c.prec())
+	for prec > precLimit && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+		prec--
+	}
+
+	if prec > precLimit {
+		switch c.ParseMode {
+		case ParseModeError:
+			return false, bint{}, 0, ErrPrecOutOfRange
+		case ParseModeTrunc:
+			n := len(digits) - (prec - precLimit)
+			if n <= 0 {
+				return false, bint{}, 0, nil
+			}
+
+			digits = digits[:n]
+			prec = precLimit
+		default:
+			return false, bint{}, 0, errInvalidParseMode(c.ParseMode)
+		}
+	}
+
+	if prec < 0 {
+		// prevent numbers that can't be written in maxStrLen digits, e.g. 1e1000
+		if len(digits)-prec > maxStrLen {
+			return false, bint{}, 0, ErrMaxStrLen
+		}
+
+		digits = append(digits, bytes.Repeat([]byte{'0'}, -prec)...)
+		prec = 0
+	}
+
+	coef, err := digitToU128(digits)
+	if err == nil {
+		//nolint:gosec // 0 <= prec <= maxPrec (19) and can be safely converted to uint8
+		return neg, bint{u128: coef}, uint8(prec), nil
+	}
+
+	// overflow, parse into big.Int
+	dValue, ok := new(big.Int).SetString(string(digits), 10)
+	if !ok {
+		return false, bint{}, 0, errInvalidFormat(s)
+	}
+
+	//nolint:gosec // 0 <= prec <= maxPrec (19) and can be safely converted to uint8
+	return neg, bintFromBigInt(dValue), uint8(prec), nil
+}
+
+// parseExponent parses the exponent part of a number in scientific notation: [+-]d+
+// Exponents with large absolute values are capped at maxExponent, which is enough to make the result either
+// too large (ErrMaxStrLen) or too small (ErrPrecOutOfRange or zero).
+func parseExponent(s []byte) (int, bool) {
+	var neg bool
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	if len(s) == 0 || !isDigits(s) {
+		return 0, false
+	}
+
+	var exp int
+	for _, c := range s {
+		exp = min(exp*10+int(c-'0'), maxExponent)
+	}
+
+	if neg {
+		exp = -exp
+	}
+
+	return exp, true
+}
+
+// exponentIndex returns the index of the first 'e' or 'E' in s, or -1 if there is none
+func exponentIndex(s []byte) int {
+	for i, c := range s {
+		if c == 'e' || c == 'E' {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isDigits(s []byte) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c Context) parseBintFromU128(s []byte) (bool, bint, uint8, error) {
 	width := len(s)
 
 	var (
@@ -242,9 +430,9 @@ func parseBintFromU128(s []byte) (bool, bint, uint8, error) {
 	)
 
 	if len(s[pos:]) <= maxDigitU64 {
-		coef, prec, err = parseSmallToU128(s[pos:])
+		coef, prec, err = c.parseSmallToU128(s[pos:])
 	} else {
-		coef, prec, err = parseLargeToU128(s[pos:])
+		coef, prec, err = c.parseLargeToU128(s[pos:])
 	}
 
 	if err == ErrInvalidFormat {
@@ -254,10 +442,11 @@ func parseBintFromU128(s []byte) (bool, bint, uint8, error) {
 	return neg, bint{u128: coef}, prec, err
 }
 
-func parseSmallToU128(s []byte) (u128, uint8, error) {
+func (c Context) parseSmallToU128(s []byte) (u128, uint8, error) {
 	var (
-		coef uint64
-		prec uint8
+		coef      uint64
+		prec      uint8
+		precLimit = c.prec()
 	)
 
 	for i := 0; i < len(s); i++ {
@@ -275,8 +464,18 @@ func parseSmallToU128(s []byte) (u128, uint8, error) {
 				return u128{}, 0, ErrInvalidFormat
 			}
 
-			if prec > defaultPrec {
-				return u128{}, 0, ErrPrecOutOfRange
+			if prec > precLimit {
+				switch c.ParseMode {
+				case ParseModeTrunc:
+					if !isDigits(s[i+1+int(precLimit):]) {
+						return u128{}, 0, ErrInvalidFormat
+					}
+
+					s = s[:i+1+int(precLimit)]
+					prec = precLimit
+				default:
+					return u128{}, 0, ErrPrecOutOfRange
+				}
 			}
 
 			continue
@@ -296,7 +495,7 @@ func parseSmallToU128(s []byte) (u128, uint8, error) {
 	return u128{lo: coef}, prec, nil
 }
 
-func parseLargeToU128(s []byte) (u128, uint8, error) {
+func (c Context) parseLargeToU128(s []byte) (u128, uint8, error) {
 	// find '.' position
 	l := len(s)
 	pos := bytes.IndexByte(s, '.')
@@ -318,18 +517,23 @@ func parseLargeToU128(s []byte) (u128, uint8, error) {
 	// now 0 < pos < l-1
 	//nolint:gosec // l < maxStrLen, so 0 < l-pos-1 < 256, can be safely converted to uint8
 	prec := uint8(l - pos - 1)
-	switch defaultParseMode {
+	precLimit := c.prec()
+	switch c.ParseMode {
 	case ParseModeError:
-		if prec > defaultPrec {
+		if prec > precLimit {
 			return u128{}, 0, ErrPrecOutOfRange
 		}
 	case ParseModeTrunc:
-		if prec > defaultPrec {
-			s = s[:pos+1+int(defaultPrec)]
-			prec = defaultPrec
+		if prec > precLimit {
+			if !isDigits(s[pos+1+int(precLimit):]) {
+				return u128{}, 0, ErrInvalidFormat
+			}
+
+			s = s[:pos+1+int(precLimit)]
+			prec = precLimit
 		}
 	default:
-		return u128{}, 0, fmt.Errorf("invalid parse mode: %d. Make sure to use SetParseMode with a valid value", defaultParseMode)
+		return u128{}, 0, errInvalidParseMode(c.ParseMode)
 	}
 
 	// number has a decimal point, split into 2 parts: integer and fraction
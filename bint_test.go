@@ -55,6 +55,26 @@ func TestParseModeTrunc(t *testing.T) {
 			input: "-12324564654613213216546546132131265.123456789012345678999",
 			want:  "-12324564654613213216546546132131265.1234567890123456789",
 		},
+		{
+			input: "1.123456789012345678999e1",
+			want:  "11.2345678901234567899",
+		},
+		{
+			input: "-1.123456789012345678999e1",
+			want:  "-11.2345678901234567899",
+		},
+		{
+			input: "12.5e-20",
+			want:  "0.0000000000000000001",
+		},
+		{
+			input: "1e-20",
+			want:  "0",
+		},
+		{
+			input: "-1e-1000000",
+			want:  "0",
+		},
 	}
 
 	for _, tc := range testcases {
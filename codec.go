@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/bits"
+	"strconv"
 	"unsafe"
 )
 
@@ -26,6 +27,7 @@ const (
 
 var (
 	_ fmt.Stringer               = (*Decimal)(nil)
+	_ fmt.Formatter              = (*Decimal)(nil)
 	_ sql.Scanner                = (*Decimal)(nil)
 	_ driver.Valuer              = (*Decimal)(nil)
 	_ encoding.TextMarshaler     = (*Decimal)(nil)
@@ -75,6 +77,516 @@ func (d Decimal) StringFixed(prec uint8) string {
 	return d1.stringBigInt(false)
 }
 
+// StringSci returns the string representation of the decimal in scientific notation,
+// with exactly one non-zero digit before the decimal point.
+// If sigDigits > 0, the number is rounded to sigDigits significant digits using [RoundHalfEven].
+// If sigDigits is 0, all significant digits are kept. Trailing zeros are always removed.
+//
+// The result can be parsed back with [Parse], strconv.ParseFloat or JSON decoders.
+//
+// Example:
+//
+//	1234567.89.StringSci(0) -> 1.23456789e+6
+//	1234567.89.StringSci(3) -> 1.23e+6
+//	-0.00012.StringSci(0) -> -1.2e-4
+//	0.StringSci(0) -> 0e+0
+func (d Decimal) StringSci(sigDigits uint8) string {
+	var buf [maxDecimalStringU128 + 8]byte
+	return string(d.AppendSci(buf[:0], sigDigits))
+}
+
+// AppendSci appends the result of [Decimal.StringSci] to b.
+func (d Decimal) AppendSci(b []byte, sigDigits uint8) []byte {
+	return d.appendSci(b, sigDigits, false)
+}
+
+// StringEng returns the string representation of the decimal in engineering notation,
+// where the exponent is a multiple of 3 and 1 <= |mantissa| < 1000 (so it maps to SI prefixes, e.g. k, M, m, µ).
+// If sigDigits > 0, the number is rounded to sigDigits significant digits using [RoundHalfEven].
+// If sigDigits is 0, all significant digits are kept. Trailing zeros are removed from the fraction,
+// but the integer part of the mantissa is padded with zeros if needed.
+//
+// Example:
+//
+//	1234567.89.StringEng(0) -> 1.23456789e+6
+//	12345.StringEng(0) -> 12.345e+3
+//	-0.00012.StringEng(0) -> -120e-6
+//	123456.StringEng(1) -> 100e+3
+func (d Decimal) StringEng(sigDigits uint8) string {
+	var buf [maxDecimalStringU128 + 8]byte
+	return string(d.AppendEng(buf[:0], sigDigits))
+}
+
+// AppendEng appends the result of [Decimal.StringEng] to b.
+func (d Decimal) AppendEng(b []byte, sigDigits uint8) []byte {
+	return d.appendSci(b, sigDigits, true)
+}
+
+func (d Decimal) appendSci(b []byte, sigDigits uint8, eng bool) []byte {
+	var buf [maxDecimalStringU128]byte
+
+	// d = 0.digits * 10^dp
+	digs, dp := d.significantDigits(buf[:0])
+	if sigDigits > 0 {
+		digs, dp = roundDigits(digs, dp, int(sigDigits))
+	}
+
+	if d.neg {
+		b = append(b, '-')
+	}
+
+	exp := dp - 1
+	if digs[0] == '0' {
+		// zero has exponent 0
+		exp = 0
+	}
+
+	// number of digits before the decimal point
+	intDigits := 1
+	if eng {
+		// move the exponent down to a multiple of 3
+		r := ((exp % 3) + 3) % 3
+		exp -= r
+		intDigits += r
+	}
+
+	if len(digs) <= intDigits {
+		b = append(b, digs...)
+		for i := len(digs); i < intDigits; i++ {
+			b = append(b, '0')
+		}
+	} else {
+		b = append(b, digs[:intDigits]...)
+		b = append(b, '.')
+		b = append(b, digs[intDigits:]...)
+	}
+
+	b = append(b, 'e')
+	if exp < 0 {
+		b = append(b, '-')
+		exp = -exp
+	} else {
+		b = append(b, '+')
+	}
+
+	return strconv.AppendInt(b, int64(exp), 10)
+}
+
+// Format implements the [fmt.Formatter] interface, so decimals can be used with fmt.Printf and friends.
+//
+// Supported verbs:
+//
+//	%v, %s  same as String, e.g. 1.2345 (precision is ignored)
+//	%q      quoted String, e.g. "1.2345"
+//	%f, %F  decimal point, no exponent, e.g. 1.2345. Without precision, all digits are printed
+//	%e, %E  scientific notation, e.g. 1.2345e+00. Without precision, all significant digits are printed
+//	%g, %G  %e for large exponents, %f otherwise, with the same rules as for float64
+//
+// With a precision (e.g. %.2f or %.3e), the number is rounded using [RoundHalfEven], which is also
+// what fmt does for float64. Extra zeros are added if the number has fewer digits than the precision.
+//
+// Flags:
+//
+//	'+'  always print a sign for numeric verbs (%+.2f -> +1.23)
+//	' '  leave a space for the sign of positive numbers for numeric verbs (% .2f -> " 1.23")
+//	'-'  pad with spaces on the right rather than the left
+//	'0'  pad with leading zeros after the sign rather than spaces for numeric verbs
+//	'#'  always print a decimal point for %f, %e and %g, and keep the trailing zeros of %g
+//	     (%#g -> 1.00000, %#.0f -> 2.), same as fmt for float64. It's ignored by the other verbs
+//
+// Width sets the minimum width of the result, e.g. %8.2f -> "    1.23".
+// Unsupported verbs are reported like fmt does, e.g. %!d(udecimal.Decimal=1.23).
+func (d Decimal) Format(s fmt.State, verb rune) {
+	var (
+		buf     [maxDecimalStringU128 + 8]byte
+		b       = buf[:0]
+		numeric = true
+	)
+
+	prec, hasPrec := s.Precision()
+
+	switch verb {
+	case 'v', 's':
+		numeric = verb == 'v'
+		b = d.appendString(b, false)
+	case 'q':
+		numeric = false
+		b = d.appendString(b, true)
+	case 'f', 'F':
+		if hasPrec {
+			b = d.appendFixed(b, prec)
+		} else {
+			b = d.appendString(b, false)
+		}
+	case 'e', 'E', 'g', 'G':
+		if !hasPrec {
+			prec = -1
+		}
+
+		b = d.appendFloat(b, byte(verb), prec)
+	default:
+		fmt.Fprintf(s, "%%!%c(udecimal.Decimal=%s)", verb, d.String())
+		return
+	}
+
+	if s.Flag('#') && numeric && verb != 'v' {
+		if !hasPrec {
+			prec = -1
+		}
+
+		b = appendSharp(b, verb, prec)
+	}
+
+	// the sign is always the first byte if the number is negative
+	sign := 0
+	if numeric {
+		if len(b) > 0 && b[0] == '-' {
+			sign = 1
+		} else if s.Flag('+') || s.Flag(' ') {
+			sign = 1
+			b = append(b, 0)
+			copy(b[1:], b)
+
+			b[0] = '+'
+			if !s.Flag('+') {
+				b[0] = ' '
+			}
+		}
+	}
+
+	width, hasWidth := s.Width()
+	if !hasWidth || width <= len(b) {
+		_, _ = s.Write(b)
+		return
+	}
+
+	pad := width - len(b)
+
+	switch {
+	case s.Flag('-'):
+		_, _ = s.Write(b)
+		writePadding(s, ' ', pad)
+	case s.Flag('0') && numeric:
+		_, _ = s.Write(b[:sign])
+		writePadding(s, '0', pad)
+		_, _ = s.Write(b[sign:])
+	default:
+		writePadding(s, ' ', pad)
+		_, _ = s.Write(b)
+	}
+}
+
+// appendSharp applies the '#' flag to the number formatted in b, the same way fmt does for float64:
+// a decimal point is added if there is none, and %g and %G are padded with zeros
+// up to prec significant digits (6 if prec < 0)
+func appendSharp(b []byte, verb rune, prec int) []byte {
+	digits := 0
+	if verb == 'g' || verb == 'G' {
+		digits = prec
+		if digits < 0 {
+			digits = 6
+		}
+	}
+
+	start := 0
+	if len(b) > 0 && b[0] == '-' {
+		start = 1
+	}
+
+	// the exponent is moved after the zeros
+	var (
+		tailBuf [16]byte
+		tail    = tailBuf[:0]
+	)
+
+	hasDecimalPoint, sawNonzeroDigit := false, false
+
+	for i := start; i < len(b); i++ {
+		switch b[i] {
+		case '.':
+			hasDecimalPoint = true
+		case 'e', 'E':
+			tail = append(tail, b[i:]...)
+			b = b[:i]
+		default:
+			// count the significant digits from the first nonzero digit
+			sawNonzeroDigit = sawNonzeroDigit || b[i] != '0'
+			if sawNonzeroDigit {
+				digits--
+			}
+		}
+	}
+
+	if !hasDecimalPoint {
+		// a single 0 counts as a significant digit
+		if len(b)-start == 1 && b[start] == '0' {
+			digits--
+		}
+
+		b = append(b, '.')
+	}
+
+	for ; digits > 0; digits-- {
+		b = append(b, '0')
+	}
+
+	return append(b, tail...)
+}
+
+func writePadding(s fmt.State, c byte, n int) {
+	var pad [16]byte
+	for i := range pad {
+		pad[i] = c
+	}
+
+	for n > 0 {
+		k := min(n, len(pad))
+		_, _ = s.Write(pad[:k])
+		n -= k
+	}
+}
+
+// appendString appends the result of String (or MarshalJSON if withQuote is true) to b
+func (d Decimal) appendString(b []byte, withQuote bool) []byte {
+	if !d.coef.overflow() {
+		return d.appendBuffer(b, true, withQuote)
+	}
+
+	if withQuote {
+		b = append(b, '"')
+		b = append(b, d.stringBigInt(true)...)
+		return append(b, '"')
+	}
+
+	return append(b, d.stringBigInt(true)...)
+}
+
+// appendFixed appends d rounded to prec digits after the decimal point using RoundHalfEven,
+// padded with zeros if d has less than prec digits
+func (d Decimal) appendFixed(b []byte, prec int) []byte {
+	if prec < int(d.prec) {
+		//nolint:gosec // 0 <= prec < d.prec <= 19, so it's safe to convert to uint8
+		d, _ = d.round(uint8(prec), RoundHalfEven) // RoundHalfEven is valid, so it never fails
+	}
+
+	//nolint:gosec // 0 <= min(prec, maxPrec) <= 19, so it's safe to convert to uint8
+	d1 := d.rescale(uint8(min(prec, int(maxPrec))))
+
+	if !d1.coef.overflow() {
+		b = d1.appendBuffer(b, false, false)
+	} else {
+		b = append(b, d1.stringBigInt(false)...)
+	}
+
+	// more than maxPrec digits are requested, the rest are zeros
+	for i := int(maxPrec); i < prec; i++ {
+		b = append(b, '0')
+	}
+
+	return b
+}
+
+// appendFloat appends d in %e, %E, %g or %G format to b, following the same rules as strconv.AppendFloat.
+// prec < 0 means the minimum number of digits necessary to represent d exactly.
+func (d Decimal) appendFloat(b []byte, verb byte, prec int) []byte {
+	var buf [maxDecimalStringU128]byte
+
+	// digits of the coefficient without leading and trailing zeros,
+	// d = 0.digits * 10^dp
+	digs, dp := d.significantDigits(buf[:0])
+
+	if d.neg {
+		b = append(b, '-')
+	}
+
+	shortest := prec < 0
+
+	switch verb {
+	case 'e', 'E':
+		if shortest {
+			prec = len(digs) - 1
+		} else {
+			digs, dp = roundDigits(digs, dp, prec+1)
+		}
+
+		return appendExp(b, digs, dp, prec, verb)
+	default:
+		// %g, %G
+		if shortest {
+			prec = len(digs)
+		} else {
+			if prec == 0 {
+				prec = 1
+			}
+
+			digs, dp = roundDigits(digs, dp, prec)
+		}
+
+		// %e is used if the exponent from the conversion is less than -4 or greater than or equal to the precision.
+		// If precision was the shortest possible, use precision 6 for this decision.
+		eprec := prec
+		if eprec > len(digs) && len(digs) >= dp {
+			eprec = len(digs)
+		}
+
+		if shortest {
+			eprec = 6
+		}
+
+		if exp := dp - 1; exp < -4 || exp >= eprec {
+			if prec > len(digs) {
+				prec = len(digs)
+			}
+
+			return appendExp(b, digs, dp, prec-1, verb+'e'-'g')
+		}
+
+		if prec > dp {
+			prec = len(digs)
+		}
+
+		return appendDigitsFixed(b, digs, dp, max(prec-dp, 0))
+	}
+}
+
+// significantDigits appends the digits of d.coef without trailing zeros to b,
+// and returns them with the position of the decimal point, such that d = 0.digits * 10^dp.
+// Zero is returned as "0" with dp = 1.
+func (d Decimal) significantDigits(b []byte) ([]byte, int) {
+	if d.coef.IsZero() {
+		return append(b, '0'), 1
+	}
+
+	if !d.coef.overflow() {
+		b = Decimal{coef: d.coef}.appendBuffer(b, true, false)
+	} else {
+		b = d.coef.bigInt.Append(b, 10)
+	}
+
+	dp := len(b) - int(d.prec)
+
+	for len(b) > 1 && b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+	}
+
+	return b, dp
+}
+
+// roundDigits rounds digits (with the decimal point at dp) to n > 0 significant digits using RoundHalfEven
+// and removes trailing zeros
+func roundDigits(digs []byte, dp, n int) ([]byte, int) {
+	if n >= len(digs) {
+		return digs, dp
+	}
+
+	up := false
+
+	switch {
+	case digs[n] > '5':
+		up = true
+	case digs[n] == '5':
+		// digs has no trailing zeros, so there are non-zero digits after digs[n] if it's not the last digit
+		up = n+1 < len(digs) || (n > 0 && (digs[n-1]-'0')%2 == 1)
+	}
+
+	digs = digs[:n]
+
+	if up {
+		i := n - 1
+		for ; i >= 0 && digs[i] == '9'; i-- {
+			digs[i] = '0'
+		}
+
+		if i < 0 {
+			// all digits are 9, e.g. 999 -> 1000
+			digs = append(digs[:0], '1')
+			dp++
+		} else {
+			digs[i]++
+		}
+	}
+
+	for len(digs) > 1 && digs[len(digs)-1] == '0' {
+		digs = digs[:len(digs)-1]
+	}
+
+	return digs, dp
+}
+
+// appendExp appends 0.digits * 10^dp in the form d.ddddde±dd with prec digits after the decimal point
+func appendExp(b []byte, digs []byte, dp, prec int, verb byte) []byte {
+	b = append(b, digs[0])
+
+	if prec > 0 {
+		b = append(b, '.')
+
+		i := 1
+		m := min(len(digs), prec+1)
+		if i < m {
+			b = append(b, digs[i:m]...)
+			i = m
+		}
+
+		for ; i <= prec; i++ {
+			b = append(b, '0')
+		}
+	}
+
+	b = append(b, verb)
+
+	exp := dp - 1
+	if digs[0] == '0' {
+		// zero has exponent 0
+		exp = 0
+	}
+
+	if exp < 0 {
+		b = append(b, '-')
+		exp = -exp
+	} else {
+		b = append(b, '+')
+	}
+
+	// at least 2 digits
+	if exp < 10 {
+		b = append(b, '0')
+	}
+
+	return strconv.AppendInt(b, int64(exp), 10)
+}
+
+// appendDigitsFixed appends 0.digits * 10^dp with prec digits after the decimal point
+func appendDigitsFixed(b []byte, digs []byte, dp, prec int) []byte {
+	// integer part, padded with zeros
+	if dp > 0 {
+		m := min(len(digs), dp)
+		b = append(b, digs[:m]...)
+
+		for ; m < dp; m++ {
+			b = append(b, '0')
+		}
+	} else {
+		b = append(b, '0')
+	}
+
+	if prec > 0 {
+		b = append(b, '.')
+
+		for i := 0; i < prec; i++ {
+			j := dp + i
+
+			c := byte('0')
+			if j >= 0 && j < len(digs) {
+				c = digs[j]
+			}
+
+			b = append(b, c)
+		}
+	}
+
+	return b
+}
+
 func (d Decimal) stringBigInt(trimTrailingZeros bool) string {
 	str := d.coef.bigInt.String()
 	dExpInt := int(d.prec)
@@ -298,6 +810,7 @@ func (d Decimal) MarshalJSON() ([]byte, error) {
 var nullValue = []byte("null")
 
 // UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both quoted and unquoted numbers, including JSON numbers in scientific notation such as 1e-7.
 func (d *Decimal) UnmarshalJSON(data []byte) error {
 	// Remove quotes if they exist.
 	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
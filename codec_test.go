@@ -5,6 +5,7 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -56,6 +57,207 @@ func TestStringFixed(t *testing.T) {
 	}
 }
 
+func TestStringSci(t *testing.T) {
+	testcases := []struct {
+		in        string
+		sigDigits uint8
+		want      string
+		wantEng   string
+	}{
+		{"0", 0, "0e+0", "0e+0"},
+		{"0", 3, "0e+0", "0e+0"},
+		{"1", 0, "1e+0", "1e+0"},
+		{"-1", 0, "-1e+0", "-1e+0"},
+		{"10", 0, "1e+1", "10e+0"},
+		{"100", 0, "1e+2", "100e+0"},
+		{"1000", 0, "1e+3", "1e+3"},
+		{"12345", 0, "1.2345e+4", "12.345e+3"},
+		{"1234567.89", 0, "1.23456789e+6", "1.23456789e+6"},
+		{"1234567.89", 3, "1.23e+6", "1.23e+6"},
+		{"-1234567.89", 2, "-1.2e+6", "-1.2e+6"},
+		{"123456", 1, "1e+5", "100e+3"},
+		{"999.5", 3, "1e+3", "1e+3"},
+		{"999.5", 1, "1e+3", "1e+3"},
+		{"12.25", 3, "1.22e+1", "12.2e+0"},
+		{"12.35", 3, "1.24e+1", "12.4e+0"},
+		{"1.5", 30, "1.5e+0", "1.5e+0"},
+		{"0.1", 0, "1e-1", "100e-3"},
+		{"0.00012", 0, "1.2e-4", "120e-6"},
+		{"-0.00012", 0, "-1.2e-4", "-120e-6"},
+		{"0.0012", 0, "1.2e-3", "1.2e-3"},
+		{"0.000000000000000001", 0, "1e-18", "1e-18"},
+		{"0.0000000000000000001", 0, "1e-19", "100e-21"},
+		{"-0.0000000000000000001", 0, "-1e-19", "-100e-21"},
+		{"0.9999999999999999999", 5, "1e+0", "1e+0"},
+		{"123456789012345678901234567890", 0, "1.2345678901234567890123456789e+29", "123.45678901234567890123456789e+27"},
+		{"123456789012345678901234567890123456789.1234567890123456789", 5, "1.2346e+38", "123.46e+36"},
+		{"-123456789012345678901234567890123456789.1234567890123456789", 0, "-1.234567890123456789012345678901234567891234567890123456789e+38", "-123.4567890123456789012345678901234567891234567890123456789e+36"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s.StringSci(%d)", tc.in, tc.sigDigits), func(t *testing.T) {
+			d := MustParse(tc.in)
+
+			require.Equal(t, tc.want, d.StringSci(tc.sigDigits))
+			require.Equal(t, tc.wantEng, d.StringEng(tc.sigDigits))
+
+			// append to an existing buffer
+			require.Equal(t, "x="+tc.want, string(d.AppendSci([]byte("x="), tc.sigDigits)))
+			require.Equal(t, "x="+tc.wantEng, string(d.AppendEng([]byte("x="), tc.sigDigits)))
+
+			if tc.sigDigits == 0 {
+				// all digits are kept, so it can be parsed back to the same value
+				require.True(t, d.Equal(MustParse(tc.want)))
+				require.True(t, d.Equal(MustParse(tc.wantEng)))
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	testcases := []struct {
+		format string
+		in     string
+		want   string
+	}{
+		{"%v", "123.456", "123.456"},
+		{"%v", "-123.4560", "-123.456"},
+		{"%s", "123.456", "123.456"},
+		{"%+v", "123.456", "+123.456"},
+		{"% v", "123.456", " 123.456"},
+		{"%+s", "123.456", "123.456"},
+		{"%.2v", "123.456", "123.456"},
+		{"%q", "-123.4560", `"-123.456"`},
+		{"%10q", "1.2", `     "1.2"`},
+		{"%f", "123.456", "123.456"},
+		{"%F", "-0.0000000000000000001", "-0.0000000000000000001"},
+		{"%.2f", "123.456", "123.46"},
+		{"%.2f", "123.455", "123.46"},
+		{"%.2f", "123.445", "123.44"},
+		{"%.2f", "123.4450000000000000001", "123.45"},
+		{"%.2f", "-123.445", "-123.44"},
+		{"%.0f", "0.5", "0"},
+		{"%.0f", "1.5", "2"},
+		{"%.0f", "-2.5", "-2"},
+		{"%.2f", "999.995", "1000.00"},
+		{"%.2f", "-0.001", "0.00"},
+		{"%.5f", "1.2", "1.20000"},
+		{"%.25f", "0.1", "0.1000000000000000000000000"},
+		{"%.2f", "0", "0.00"},
+		{"%.2f", "12345678901234567890123456789.1254567890123456789", "12345678901234567890123456789.13"},
+		{"%e", "123.456", "1.23456e+02"},
+		{"%E", "-0.00123456", "-1.23456E-03"},
+		{"%e", "0", "0e+00"},
+		{"%e", "1", "1e+00"},
+		{"%e", "1234567890123456789", "1.234567890123456789e+18"},
+		{"%.2e", "123.456", "1.23e+02"},
+		{"%.2e", "0", "0.00e+00"},
+		{"%.1e", "9.96", "1.0e+01"},
+		{"%.0e", "25", "2e+01"},
+		{"%.0e", "35", "4e+01"},
+		{"%.5e", "1.2", "1.20000e+00"},
+		{"%.3e", "-12345678901234567890123456789.1234567890123456789", "-1.235e+28"},
+		{"%e", "12345678901234567890123456789", "1.2345678901234567890123456789e+28"},
+		{"%e", "0.0000000000000000001", "1e-19"},
+		{"%e", "12345678901234567890123456789012345678901234567890", "1.234567890123456789012345678901234567890123456789e+49"},
+		{"%g", "123.456", "123.456"},
+		{"%g", "0", "0"},
+		{"%g", "0.0001234", "0.0001234"},
+		{"%g", "0.00001234", "1.234e-05"},
+		{"%g", "123456", "123456"},
+		{"%g", "1234567", "1.234567e+06"},
+		{"%G", "1234567", "1.234567E+06"},
+		{"%.3g", "123.456", "123"},
+		{"%.3g", "1234567", "1.23e+06"},
+		{"%.10g", "1.5", "1.5"},
+		{"%.0g", "2.5", "2"},
+		{"%8.2f", "1.234", "    1.23"},
+		{"%-8.2f|", "1.234", "1.23    |"},
+		{"%08.2f", "-1.234", "-0001.23"},
+		{"%+08.2f", "1.234", "+0001.23"},
+		{"% 8.2f", "1.234", "    1.23"},
+		{"% .2f", "1.234", " 1.23"},
+		{"%+.2f", "-1.234", "-1.23"},
+		{"%-08.2f|", "1.234", "1.23    |"},
+		{"%+012.3e", "1234.5", "+001.234e+03"},
+		{"%08s", "1.2", "     1.2"},
+		{"%-6v|", "1.2", "1.2   |"},
+		{"%2v", "123.456", "123.456"},
+		{"%34.2f", "12345678901234567890123456789.1234567890123456789", "  12345678901234567890123456789.12"},
+		{"%d", "1.2", "%!d(udecimal.Decimal=1.2)"},
+		{"%x", "-1.2", "%!x(udecimal.Decimal=-1.2)"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s", tc.format, tc.in), func(t *testing.T) {
+			require.Equal(t, tc.want, fmt.Sprintf(tc.format, MustParse(tc.in)))
+		})
+	}
+}
+
+func TestFormatSharp(t *testing.T) {
+	// same as fmt for float64, except for %e without precision, which prints all the digits instead of 6,
+	// and the negative numbers rounded to 0, which have no sign
+	formats := []string{"%#g", "%#G", "%#.3g", "%#.0g", "%#.10g", "%#.0e", "%#.2e", "%#.0f", "%#.2f", "%#10.3g", "%-#10.0f|", "%+#g", "%#08.0f"}
+	values := []string{"0", "1", "-1", "2.5", "100", "123.456", "-1.5", "0.000012", "1234567", "123456", "1e21", "0.0001"}
+
+	for _, format := range formats {
+		for _, v := range values {
+			t.Run(format+" "+v, func(t *testing.T) {
+				f, err := strconv.ParseFloat(v, 64)
+				require.NoError(t, err)
+
+				require.Equal(t, fmt.Sprintf(format, f), fmt.Sprintf(format, MustParse(v)))
+			})
+		}
+	}
+
+	testcases := []struct {
+		format string
+		in     string
+		want   string
+	}{
+		// without precision, %f prints all the digits, with a decimal point
+		{"%#f", "12", "12."},
+		{"%#f", "1.5", "1.5"},
+		{"%#.25f", "0.1", "0.1000000000000000000000000"},
+		{"%#.25g", "0.1", "0.1000000000000000000000000"},
+		{"%#e", "1", "1.e+00"},
+		{"%#e", "2.5", "2.5e+00"},
+		{"%#.0f", "-0.5", "0."},
+		{"%#e", "12345678901234567890123456789", "1.2345678901234567890123456789e+28"},
+
+		// ignored by the other verbs
+		{"%#v", "12.50", "12.5"},
+		{"%#s", "12", "12"},
+		{"%#q", "12", `"12"`},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.format+" "+tc.in, func(t *testing.T) {
+			require.Equal(t, tc.want, fmt.Sprintf(tc.format, MustParse(tc.in)))
+		})
+	}
+}
+
+func TestFormatCompatibility(t *testing.T) {
+	// %v and %s must be the same as String, which is used by fmt.Println and friends
+	testcases := []string{
+		"0", "1", "-1", "123.456", "-0.0000000000000000001", "1234567890123456789.1234567890123456789",
+		"-12345678901234567890123456789.1234567890123456789",
+	}
+
+	for _, tc := range testcases {
+		d := MustParse(tc)
+
+		require.Equal(t, d.String(), fmt.Sprint(d))
+		require.Equal(t, d.String(), fmt.Sprintf("%v", d))
+		require.Equal(t, d.String(), fmt.Sprintf("%s", d))
+		require.Equal(t, "["+d.String()+"]", fmt.Sprint([]Decimal{d}))
+		require.Equal(t, fmt.Sprintf("{%s}", d), fmt.Sprintf("%v", struct{ D Decimal }{d}))
+	}
+}
+
 func TestMarshalText(t *testing.T) {
 	testcases := []struct {
 		in string
@@ -95,9 +297,9 @@ func TestUnmarshalText(t *testing.T) {
 		wantErrType error
 	}{
 		{"", "error unmarshaling to Decimal: can't parse empty string", ErrEmptyString},
-		{" ", "error unmarshaling to Decimal: invalid format: can't parse ' '", ErrInvalidFormat},
-		{"abc", "error unmarshaling to Decimal: invalid format: can't parse 'abc'", ErrInvalidFormat},
-		{"1234567890123.12345678901234567899", "error unmarshaling to Decimal: precision out of range. Only support maximum 19 digits after the decimal point", ErrPrecOutOfRange},
+		{" ", "error unmarshaling to Decimal: invalid format: can't parse ' ' at offset 0: unexpected character ' '", ErrInvalidFormat},
+		{"abc", "error unmarshaling to Decimal: invalid format: can't parse 'abc' at offset 0: unexpected character 'a'", ErrInvalidFormat},
+		{"1234567890123.12345678901234567899", "error unmarshaling to Decimal: precision out of range. Only support maximum 19 digits after the decimal point: can't parse '1234567890123.12345678901234567899' at offset 33: too many digits after the decimal point", ErrPrecOutOfRange},
 		{"1234567890123.1234567890123", "", nil},
 	}
 
@@ -189,6 +391,37 @@ func TestUnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestUnmarshalJSONScientific(t *testing.T) {
+	testcases := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{"1e-7", "0.0000001", nil},
+		{"1.23E+4", "12300", nil},
+		{"-2.5e3", "-2500", nil},
+		{`"5e-3"`, "0.005", nil},
+		{"1e-20", "", ErrPrecOutOfRange},
+		{`"1e"`, "", ErrInvalidFormat},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			s := fmt.Sprintf(`{"price":%s}`, tc.in)
+
+			var test Test
+			err := json.Unmarshal([]byte(s), &test)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, test.Test.String())
+		})
+	}
+}
+
 func TestUnmarshalJSONNull(t *testing.T) {
 	var test Test
 	err := json.Unmarshal([]byte(`{"price": null}`), &test)
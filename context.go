@@ -0,0 +1,246 @@
+package udecimal
+
+// Context holds the settings used by arithmetic and parsing operations, such as the
+// number of digits kept after the decimal point and how parsing handles numbers with more fraction digits.
+//
+// Unlike [SetDefaultPrecision] and [SetDefaultParseMode], a Context never reads or modifies package-level state.
+// Different parts of an application (or different libraries in the same binary) can use different settings
+// at the same time without affecting each other.
+//
+// The package-level functions and methods, such as [Parse] and [Decimal.Div], are equivalent to
+// calling the same method on [DefaultContext].
+//
+// The zero value is a valid Context with Prec = 0, [ParseModeError] and [RoundDown].
+//
+// Example:
+//
+//	ctx := udecimal.Context{Prec: 4}
+//	ctx.Div(udecimal.MustParse("1"), udecimal.MustParse("3")) // 0.3333
+type Context struct {
+	// Prec is the maximum number of digits after the decimal point of the result
+	// of Mul, MulSat, Div, Div64, Sqrt, Cbrt, NthRoot, PowInt32, PowToIntPart, Pow, Exp, Ln, Log10 and Log2,
+	// and of the numbers accepted by Parse.
+	// Extra digits are truncated, except for Div and Div64 which use Rounding.
+	// Values greater than 19 are treated as 19.
+	Prec uint8
+
+	// ParseMode controls how Parse handles numbers that have more than Prec digits
+	// after the decimal point. See [ParseMode] for more details.
+	ParseMode ParseMode
+
+	// Rounding is the rounding mode used by Div and Div64 when the quotient has more than Prec digits
+	// after the decimal point. The zero value [RoundDown] truncates the extra digits.
+	Rounding RoundingMode
+
+	// Overflow controls what Add, Sub and Mul do when the coefficient of the result doesn't fit in 128 bits.
+	// The zero value [OverflowModeBigInt] switches to *big.Int, see [OverflowMode] for more details.
+	Overflow OverflowMode
+
+	// MaxMagnitude is the maximum absolute value of the results of AddSat, SubSat and MulSat. Its sign is ignored.
+	// A zero MaxMagnitude (the zero value, or any 0 such as 0.00) means no bound other than the largest value
+	// whose coefficient fits in 128 bits at the precision of the result, so a bound of exactly 0 can't be set.
+	MaxMagnitude Decimal
+}
+
+// DefaultContext returns the context used by the package-level functions and methods.
+// Its settings are the ones configured with [SetDefaultPrecision] and [SetDefaultParseMode].
+func DefaultContext() Context {
+	return Context{
+		Prec:      defaultPrec,
+		ParseMode: defaultParseMode,
+	}
+}
+
+// MaxPrecisionContext returns a context that keeps the maximum of 19 digits after the decimal point,
+// whatever the default precision. Use it for intermediate results that must lose as few digits as possible.
+//
+// Its Add, Sub and Mul never return errors: they switch to *big.Int when the coefficient doesn't fit in 128 bits.
+func MaxPrecisionContext() Context {
+	return Context{Prec: maxPrec}
+}
+
+// prec returns c.Prec capped at maxPrec
+func (c Context) prec() uint8 {
+	return min(c.Prec, maxPrec)
+}
+
+// Parse parses a number in string to a decimal using the settings of c.
+// See [Parse] for the accepted format.
+//
+// Returns error if:
+//  1. empty/invalid string
+//  2. the number has more than c.Prec digits after the decimal point and c.ParseMode is [ParseModeError]
+//  3. string length exceeds maxStrLen (which is 200 characters. See [ErrMaxStrLen] for more details)
+func (c Context) Parse(s string) (Decimal, error) {
+	return c.parseBytes(unsafeStringToBytes(s))
+}
+
+func (c Context) parseBytes(b []byte) (Decimal, error) {
+	neg, bint, prec, err := c.parseBint(b)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return newDecimal(neg, bint, prec), nil
+}
+
+// Add returns d + e.
+//
+// Returns an [*OverflowError] if c.Overflow is [OverflowModeError] and the coefficient of the result
+// doesn't fit in 128 bits, or [ErrInvalidOverflowMode] if c.Overflow is invalid
+func (c Context) Add(d, e Decimal) (Decimal, error) {
+	switch c.Overflow {
+	case OverflowModeBigInt:
+		return d.Add(e), nil
+	case OverflowModeError:
+		return d.AddChecked(e)
+	default:
+		return Decimal{}, ErrInvalidOverflowMode
+	}
+}
+
+// Sub returns d - e.
+//
+// Returns an [*OverflowError] if c.Overflow is [OverflowModeError] and the coefficient of the result
+// doesn't fit in 128 bits, or [ErrInvalidOverflowMode] if c.Overflow is invalid
+func (c Context) Sub(d, e Decimal) (Decimal, error) {
+	switch c.Overflow {
+	case OverflowModeBigInt:
+		return d.Sub(e), nil
+	case OverflowModeError:
+		return d.SubChecked(e)
+	default:
+		return Decimal{}, ErrInvalidOverflowMode
+	}
+}
+
+// Mul returns d * e.
+// The result will have at most c.Prec digits after the decimal point, extra digits are truncated.
+//
+// Returns an [*OverflowError] if c.Overflow is [OverflowModeError] and the coefficient of the result
+// doesn't fit in 128 bits, or [ErrInvalidOverflowMode] if c.Overflow is invalid
+func (c Context) Mul(d, e Decimal) (Decimal, error) {
+	switch c.Overflow {
+	case OverflowModeBigInt:
+		return d.mul(e, c.prec()), nil
+	case OverflowModeError:
+		return d.mulChecked(e, c.prec())
+	default:
+		return Decimal{}, ErrInvalidOverflowMode
+	}
+}
+
+// AddSat returns d + e, saturated to c.MaxMagnitude.
+// The returned bool reports whether the result was saturated.
+// If c.MaxMagnitude is zero, the bound is the same as [Decimal.AddSat].
+func (c Context) AddSat(d, e Decimal) (Decimal, bool) {
+	return d.addSat(e, c.MaxMagnitude)
+}
+
+// SubSat returns d - e, saturated to c.MaxMagnitude.
+// The returned bool reports whether the result was saturated.
+// If c.MaxMagnitude is zero, the bound is the same as [Decimal.SubSat].
+func (c Context) SubSat(d, e Decimal) (Decimal, bool) {
+	return d.addSat(e.Neg(), c.MaxMagnitude)
+}
+
+// MulSat returns d * e, saturated to c.MaxMagnitude.
+// The result will have at most c.Prec digits after the decimal point, extra digits are truncated.
+// The returned bool reports whether the result was saturated.
+// If c.MaxMagnitude is zero, the bound is the same as [Decimal.MulSat].
+func (c Context) MulSat(d, e Decimal) (Decimal, bool) {
+	return d.mulSat(e, c.prec(), c.MaxMagnitude)
+}
+
+// Div returns d / e.
+// If the result has more than c.Prec fraction digits, it will be rounded to c.Prec digits using c.Rounding.
+//
+// Returns error if e is zero or c.Rounding is invalid
+func (c Context) Div(d, e Decimal) (Decimal, error) {
+	if c.Rounding == RoundDown {
+		return d.div(e, c.prec())
+	}
+
+	return d.divRound(e, c.prec(), c.Rounding)
+}
+
+// Div64 returns d / v where v is a uint64.
+// If the result has more than c.Prec fraction digits, it will be rounded to c.Prec digits using c.Rounding.
+//
+// Returns error if v is zero or c.Rounding is invalid
+func (c Context) Div64(d Decimal, v uint64) (Decimal, error) {
+	if c.Rounding == RoundDown {
+		return d.div64(v, c.prec())
+	}
+
+	return d.Div64Round(v, c.prec(), c.Rounding)
+}
+
+// Sqrt returns the square root of d.
+// The result will have at most c.Prec digits after the decimal point.
+// Returns error if d < 0
+func (c Context) Sqrt(d Decimal) (Decimal, error) {
+	return d.sqrt(c.prec())
+}
+
+// Cbrt returns the cube root of d.
+// The result will have at most c.Prec digits after the decimal point.
+func (c Context) Cbrt(d Decimal) (Decimal, error) {
+	return d.nthRoot(3, c.prec())
+}
+
+// NthRoot returns the nth root of d.
+// The result will have at most c.Prec digits after the decimal point.
+// See [Decimal.NthRoot] for special cases.
+func (c Context) NthRoot(d Decimal, n int) (Decimal, error) {
+	return d.nthRoot(n, c.prec())
+}
+
+// PowInt32 returns d raised to the power of e, where e is an int32.
+// The result will have at most c.Prec digits after the decimal point.
+// See [Decimal.PowInt32] for special cases.
+func (c Context) PowInt32(d Decimal, e int32) (Decimal, error) {
+	return d.powInt32(e, c.prec())
+}
+
+// PowToIntPart raises the decimal d to the power of integer part of e (d^int(e)).
+// The result will have at most c.Prec digits after the decimal point.
+// See [Decimal.PowToIntPart] for special cases.
+func (c Context) PowToIntPart(d, e Decimal) (Decimal, error) {
+	return d.powToIntPart(e, c.prec())
+}
+
+// Pow returns d raised to the power of e (d^e), where e can have a fractional part.
+// The result will have at most c.Prec digits after the decimal point.
+// See [Decimal.Pow] for special cases.
+func (c Context) Pow(d, e Decimal) (Decimal, error) {
+	return d.pow(e, c.prec())
+}
+
+// Exp returns e raised to the power of d (e^d).
+// The result will have at most c.Prec digits after the decimal point.
+// Returns error if d > 414
+func (c Context) Exp(d Decimal) (Decimal, error) {
+	return d.exp(c.prec())
+}
+
+// Ln returns the natural logarithm of d.
+// The result will have at most c.Prec digits after the decimal point.
+// Returns error if d <= 0
+func (c Context) Ln(d Decimal) (Decimal, error) {
+	return d.ln(c.prec())
+}
+
+// Log10 returns the base 10 logarithm of d.
+// The result will have at most c.Prec digits after the decimal point.
+// Returns error if d <= 0
+func (c Context) Log10(d Decimal) (Decimal, error) {
+	return d.log10(c.prec())
+}
+
+// Log2 returns the base 2 logarithm of d.
+// The result will have at most c.Prec digits after the decimal point.
+// Returns error if d <= 0
+func (c Context) Log2(d Decimal) (Decimal, error) {
+	return d.log2(c.prec())
+}
@@ -0,0 +1,459 @@
+package udecimal
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultContext(t *testing.T) {
+	defer SetDefaultPrecision(maxPrec)
+	defer SetDefaultParseMode(ParseModeError)
+
+	require.Equal(t, Context{Prec: 19, ParseMode: ParseModeError}, DefaultContext())
+
+	SetDefaultPrecision(10)
+	SetDefaultParseMode(ParseModeTrunc)
+	require.Equal(t, Context{Prec: 10, ParseMode: ParseModeTrunc}, DefaultContext())
+}
+
+func TestMaxPrecisionContext(t *testing.T) {
+	defer SetDefaultPrecision(maxPrec)
+
+	SetDefaultPrecision(2)
+	require.Equal(t, Context{Prec: 19}, MaxPrecisionContext())
+
+	q, err := MaxPrecisionContext().Div(One, MustParse("3"))
+	require.NoError(t, err)
+	require.Equal(t, "0.3333333333333333333", q.String())
+
+	// overflows to *big.Int instead of returning an error
+	_, err = MaxPrecisionContext().Mul(MustParse("123456789012345678901234567890"), MustParse("123456789012345678901234567890"))
+	require.NoError(t, err)
+}
+
+func TestContextParse(t *testing.T) {
+	testcases := []struct {
+		ctx     Context
+		input   string
+		want    string
+		wantErr error
+	}{
+		{Context{Prec: 2}, "1.23", "1.23", nil},
+		{Context{Prec: 2}, "-1.2", "-1.2", nil},
+		{Context{Prec: 2}, "1.234", "", ErrPrecOutOfRange},
+		{Context{Prec: 2}, "123456789012345678901.234", "", ErrPrecOutOfRange},
+		{Context{Prec: 2}, "1234567890123456789012345678901234567890123.234", "", ErrPrecOutOfRange},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1.239", "1.23", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "-1.239", "-1.23", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "123456789012345678901.239", "123456789012345678901.23", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1234567890123456789012345678901234567890123.239", "1234567890123456789012345678901234567890123.23", nil},
+		{Context{Prec: 0, ParseMode: ParseModeTrunc}, "1.9", "1", nil},
+		{Context{Prec: 0, ParseMode: ParseModeTrunc}, "123456789012345678901.9", "123456789012345678901", nil},
+		{Context{Prec: 0}, "123", "123", nil},
+		{Context{Prec: 0}, "1.5", "", ErrPrecOutOfRange},
+		{Context{Prec: 30}, "0.1234567890123456789", "0.1234567890123456789", nil},
+		{Context{Prec: 30}, "0.12345678901234567891", "", ErrPrecOutOfRange},
+		{Context{Prec: 2}, "1.2345e2", "123.45", nil},
+		{Context{Prec: 2}, "1.23e-2", "", ErrPrecOutOfRange},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1.23e-2", "0.01", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1.2345e-1", "0.12", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "123456789012345678901.2345e-1", "12345678901234567890.12", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1234567890123456789012345678901234567890123.2345e-1", "123456789012345678901234567890123456789012.32", nil},
+		{Context{Prec: 0}, "1.5e1", "15", nil},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1.23a", "", ErrInvalidFormat},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "12345678901234567890.23.4", "", ErrInvalidFormat},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1234567890123456789012345678901234567890123.23-", "", ErrInvalidFormat},
+		{Context{Prec: 0, ParseMode: ParseModeTrunc}, "1.59e1", "15", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%+v %s", tc.ctx, tc.input), func(t *testing.T) {
+			d, err := tc.ctx.Parse(tc.input)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, d.String())
+		})
+	}
+}
+
+func TestContextAddSubMul(t *testing.T) {
+	testcases := []struct {
+		ctx     Context
+		op      string
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{Context{}, "+", "1.23", "4.5", "5.73", nil},
+		{Context{}, "+", maxU128Str, "1", "340282366920938463463374607431768211456", nil},
+		{Context{Overflow: OverflowModeError}, "+", "1.23", "4.5", "5.73", nil},
+		{Context{Overflow: OverflowModeError}, "+", maxU128Str, "1", "", ErrOverflow},
+		{Context{Overflow: 2}, "+", "1", "1", "", ErrInvalidOverflowMode},
+		{Context{}, "-", "1.23", "4.5", "-3.27", nil},
+		{Context{}, "-", "-" + maxU128Str, "1", "-340282366920938463463374607431768211456", nil},
+		{Context{Overflow: OverflowModeError}, "-", "-" + maxU128Str, "1", "", ErrOverflow},
+		{Context{Overflow: 2}, "-", "1", "1", "", ErrInvalidOverflowMode},
+		{Context{Prec: 19}, "*", "1.23", "1.23", "1.5129", nil},
+		{Context{Prec: 2}, "*", "1.23", "1.23", "1.51", nil},
+		{Context{Prec: 2}, "*", "-1.23", "1.23", "-1.51", nil},
+		{Context{Prec: 0}, "*", "1.5", "1.5", "2", nil},
+		{Context{Prec: 30}, "*", "0.1234567890123456789", "0.1234567890123456789", "0.0152415787532388367", nil},
+		{Context{Prec: 2}, "*", "18446744073709551616", "18446744073709551616", "340282366920938463463374607431768211456", nil},
+		{Context{Prec: 2}, "*", "12345678901234567890123456789012345678901.23", "0.001", "12345678901234567890123456789012345678.9", nil},
+		{Context{Prec: 2, Overflow: OverflowModeError}, "*", "1.23", "1.23", "1.51", nil},
+		{Context{Prec: 2, Overflow: OverflowModeError}, "*", "3402823669209384634.555", "1.0000001", "3402824009491751555.49", nil},
+		{Context{Prec: 2, Overflow: OverflowModeError}, "*", "18446744073709551616", "18446744073709551616", "", ErrOverflow},
+		{Context{Overflow: 2}, "*", "1", "1", "", ErrInvalidOverflowMode},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%+v %s%s%s", tc.ctx, tc.a, tc.op, tc.b), func(t *testing.T) {
+			a, b := MustParse(tc.a), MustParse(tc.b)
+
+			var (
+				c   Decimal
+				err error
+			)
+
+			switch tc.op {
+			case "+":
+				c, err = tc.ctx.Add(a, b)
+			case "-":
+				c, err = tc.ctx.Sub(a, b)
+			case "*":
+				c, err = tc.ctx.Mul(a, b)
+			}
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+		})
+	}
+
+	// the default context is the same as the Decimal methods
+	a, b := MustParse("1.2345678901"), MustParse("9.8765432109876")
+	c, err := DefaultContext().Mul(a, b)
+	require.NoError(t, err)
+	require.Equal(t, a.Mul(b), c)
+}
+
+func TestContextDiv(t *testing.T) {
+	testcases := []struct {
+		prec    uint8
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{4, "1", "3", "0.3333", nil},
+		{4, "-2", "3", "-0.6666", nil},
+		{4, "1.23456789", "1", "1.2345", nil},
+		{4, "1.23456789", "0.5", "2.4691", nil},
+		{4, "0.00001", "1", "0", nil},
+		{0, "-10", "4", "-2", nil},
+		{0, "1", "3", "0", nil},
+		{2, "123456789012345678901234567890123456789.123", "0.001", "123456789012345678901234567890123456789123", nil},
+		{2, "1234567890123456789012345678901234567890.123", "7", "176366841446208112716049382700176366841.44", nil},
+		{19, "1", "3", "0.3333333333333333333", nil},
+		{30, "1", "3", "0.3333333333333333333", nil},
+		{4, "1", "0", "", ErrDivideByZero},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s/%s", tc.prec, tc.a, tc.b), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+
+			c, err := ctx.Div(MustParse(tc.a), MustParse(tc.b))
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+		})
+	}
+}
+
+func TestContextDiv64(t *testing.T) {
+	testcases := []struct {
+		prec    uint8
+		a       string
+		b       uint64
+		want    string
+		wantErr error
+	}{
+		{2, "10", 3, "3.33", nil},
+		{2, "1.999", 2, "0.99", nil},
+		{2, "1.999", 1, "1.99", nil},
+		{0, "-7", 2, "-3", nil},
+		{2, "1234567890123456789012345678901234567890.123", 7, "176366841446208112716049382700176366841.44", nil},
+		{2, "1", 0, "", ErrDivideByZero},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s/%d", tc.prec, tc.a, tc.b), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+
+			c, err := ctx.Div64(MustParse(tc.a), tc.b)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+		})
+	}
+}
+
+func TestContextRounding(t *testing.T) {
+	ctx := Context{Prec: 2, Rounding: RoundHalfEven}
+
+	q, err := ctx.Div(MustParse("1"), MustParse("8"))
+	require.NoError(t, err)
+	require.Equal(t, "0.12", q.String())
+
+	q, err = ctx.Div64(MustParse("3"), 8)
+	require.NoError(t, err)
+	require.Equal(t, "0.38", q.String())
+
+	ctx.Rounding = RoundUp
+
+	q, err = ctx.Div(MustParse("-1"), MustParse("3"))
+	require.NoError(t, err)
+	require.Equal(t, "-0.34", q.String())
+
+	q, err = ctx.Div64(MustParse("1.2345"), 1)
+	require.NoError(t, err)
+	require.Equal(t, "1.24", q.String())
+
+	ctx.Rounding = RoundingMode(100)
+
+	_, err = ctx.Div(MustParse("1"), MustParse("3"))
+	require.Equal(t, ErrInvalidRoundingMode, err)
+
+	_, err = ctx.Div64(MustParse("1"), 3)
+	require.Equal(t, ErrInvalidRoundingMode, err)
+}
+
+func TestContextSqrt(t *testing.T) {
+	testcases := []struct {
+		prec    uint8
+		a       string
+		want    string
+		wantErr error
+	}{
+		{4, "2", "1.4142", nil},
+		{0, "99", "9", nil},
+		{3, "0.00000001", "0", nil},
+		{3, "0.000001", "0.001", nil},
+		{3, "0.0000019", "0.001", nil},
+		{2, "12345678901234567890123456789012345678901234567890", "3513641828820144253111222.38", nil},
+		{4, "-1", "", ErrSqrtNegative},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s", tc.prec, tc.a), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+
+			c, err := ctx.Sqrt(MustParse(tc.a))
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+		})
+	}
+}
+
+func TestContextNthRoot(t *testing.T) {
+	testcases := []struct {
+		prec    uint8
+		a       string
+		n       int
+		want    string
+		wantErr error
+	}{
+		{4, "2", 3, "1.2599", nil},
+		{4, "-2", 3, "-1.2599", nil},
+		{0, "26", 3, "2", nil},
+		{3, "0.000000001", 3, "0.001", nil},
+		{3, "0.0000000009", 3, "0", nil},
+		{2, "12345678901234567890123456789012345678901234567890", 3, "23112042409018362.51", nil},
+		{6, "2", 4, "1.189207", nil},
+		{30, "2", 3, "1.2599210498948731647", nil},
+		{4, "-2", 4, "", ErrEvenRootNegative},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s %d", tc.prec, tc.a, tc.n), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+
+			c, err := ctx.NthRoot(MustParse(tc.a), tc.n)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+
+			if tc.n == 3 {
+				c, err = ctx.Cbrt(MustParse(tc.a))
+				require.NoError(t, err)
+				require.Equal(t, tc.want, c.String())
+			}
+		})
+	}
+}
+
+func TestContextPow(t *testing.T) {
+	testcases := []struct {
+		prec uint8
+		a    string
+		e    int32
+		want string
+	}{
+		{2, "1.5", 3, "3.37"},
+		{2, "1.2345", 1, "1.23"},
+		{2, "1.2345", 0, "1"},
+		{0, "2", -1, "0"},
+		{3, "2", -3, "0.125"},
+		{2, "3", -1, "0.33"},
+		{1, "123456789.123", 5, "28679718745865200877945868104851154695298.6"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s^%d", tc.prec, tc.a, tc.e), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+
+			c, err := ctx.PowInt32(MustParse(tc.a), tc.e)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+
+			// the fractional part of the exponent is ignored
+			c, err = ctx.PowToIntPart(MustParse(tc.a), MustParse(fmt.Sprintf("%d.9", tc.e)))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+		})
+	}
+}
+
+func TestContextExpLog(t *testing.T) {
+	testcases := []struct {
+		prec                 uint8
+		a                    string
+		exp, ln, log10, log2 string
+	}{
+		{0, "2", "7", "0", "0", "1"},
+		{4, "2", "7.389", "0.6931", "0.301", "1"},
+		{4, "0.5", "1.6487", "-0.6931", "-0.301", "-1"},
+		{2, "100", "26881171418161354484126255515800135873611118.77", "4.6", "2", "6.64"},
+		{10, "3.5", "33.1154519586", "1.2527629684", "0.5440680443", "1.807354922"},
+		{30, "2", "7.3890560989306502272", "0.6931471805599453094", "0.3010299956639811952", "1"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s", tc.prec, tc.a), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+			a := MustParse(tc.a)
+
+			b, err := ctx.Exp(a)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, b.String())
+
+			b, err = ctx.Ln(a)
+			require.NoError(t, err)
+			require.Equal(t, tc.ln, b.String())
+
+			b, err = ctx.Log10(a)
+			require.NoError(t, err)
+			require.Equal(t, tc.log10, b.String())
+
+			b, err = ctx.Log2(a)
+			require.NoError(t, err)
+			require.Equal(t, tc.log2, b.String())
+		})
+	}
+}
+
+func TestContextPowFractional(t *testing.T) {
+	testcases := []struct {
+		prec    uint8
+		a, e    string
+		want    string
+		wantErr error
+	}{
+		{4, "2", "0.5", "1.4142", nil},
+		{4, "0.5", "0.5", "0.7071", nil},
+		{2, "100", "0.5", "10", nil},
+		{10, "3.5", "0.5", "1.8708286933", nil},
+		{0, "2", "0.5", "1", nil},
+		{2, "1.5", "2", "2.25", nil},
+		{1, "1.5", "2", "2.2", nil},
+		{2, "1.5129", "0.5", "1.23", nil},
+		{3, "2.25", "1.5", "3.375", nil},
+		{3, "4", "-1.5", "0.125", nil},
+
+		// just below a unit boundary but not exact: 1.2299999959..., 1.2345669999999594...
+		{2, "1.51289999", "0.5", "1.22", nil},
+		{6, "1.5241556774889", "0.5", "1.234566", nil},
+		{2, "-1", "0.5", "", ErrNegativeFractionalPow},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%d %s^%s", tc.prec, tc.a, tc.e), func(t *testing.T) {
+			ctx := Context{Prec: tc.prec}
+
+			c, err := ctx.Pow(MustParse(tc.a), MustParse(tc.e))
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+		})
+	}
+}
+
+func TestContextConcurrent(t *testing.T) {
+	// contexts with different settings can be used at the same time without affecting each other
+	var wg sync.WaitGroup
+
+	for prec := uint8(0); prec <= maxPrec; prec++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ctx := Context{Prec: prec}
+
+			for range 100 {
+				q, err := ctx.Div(One, MustFromInt64(3, 0))
+				require.NoError(t, err)
+				require.Equal(t, prec, q.PrecUint())
+
+				// the default context is unaffected
+				q, err = One.Div64(3)
+				require.NoError(t, err)
+				require.Equal(t, "0.3333333333333333333", q.String())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
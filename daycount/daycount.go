@@ -0,0 +1,283 @@
+package daycount
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+var (
+	// ErrInvalidConvention is returned when the convention is not one of the defined conventions
+	ErrInvalidConvention = fmt.Errorf("invalid day count convention")
+
+	// ErrInvalidDates is returned when the end date is before the start date
+	ErrInvalidDates = fmt.Errorf("invalid dates. End date must not be before start date")
+)
+
+// Convention is a day count convention, which defines the fraction of a year between 2 dates.
+type Convention uint8
+
+const (
+	// Act360 (ACT/360, Actual/360, French) divides the actual number of days by 360.
+	// It's used by most money market instruments.
+	Act360 Convention = iota
+
+	// Act365Fixed (ACT/365F, Actual/365 Fixed, English) divides the actual number of days by 365,
+	// even in leap years.
+	Act365Fixed
+
+	// ActActISDA (ACT/ACT ISDA, Actual/Actual ISDA) divides the days in leap years by 366
+	// and the days in other years by 365, e.g. 2023-12-01 to 2024-02-01 is 31/365 + 31/366.
+	ActActISDA
+
+	// Thirty360US (30/360 US, Bond Basis) counts every month as 30 days and a year as 360 days,
+	// with these adjustments, in this order:
+	//   - if both dates are the last day of February, the end day becomes 30
+	//   - if the start date is the last day of February, the start day becomes 30
+	//   - if the end day is 31 and the start day is 30 or 31, the end day becomes 30
+	//   - if the start day is 31, it becomes 30
+	//
+	// It's basis 0 of the Excel functions, such as YEARFRAC and PRICE.
+	Thirty360US
+
+	// Thirty360European (30E/360, Eurobond Basis) counts every month as 30 days and a year as 360 days.
+	// A day 31 becomes 30, for both dates. February is not adjusted.
+	//
+	// It's basis 4 of the Excel functions, such as YEARFRAC and PRICE.
+	Thirty360European
+)
+
+var conventionNames = [...]string{
+	Act360:            "ACT/360",
+	Act365Fixed:       "ACT/365F",
+	ActActISDA:        "ACT/ACT ISDA",
+	Thirty360US:       "30/360 US",
+	Thirty360European: "30E/360",
+}
+
+// String returns the usual name of the convention, e.g. "ACT/360".
+func (c Convention) String() string {
+	if !c.valid() {
+		return fmt.Sprintf("Convention(%d)", c)
+	}
+
+	return conventionNames[c]
+}
+
+func (c Convention) valid() bool {
+	return c <= Thirty360European
+}
+
+// Days returns the actual number of calendar days from start to end, negative if end is before start.
+// Only the date part of start and end (in their own location) is used, like in all the conventions.
+// It's the day count of [Act360], [Act365Fixed] and [ActActISDA], without their check on the order of the dates.
+//
+// Example:
+//
+//	Days(2024-01-15, 2024-07-15) = 182
+//	Days(2024-07-15, 2024-01-15) = -182
+func Days(start, end time.Time) int64 {
+	return (civil(end).Unix() - civil(start).Unix()) / (24 * 60 * 60)
+}
+
+// DayCount returns the number of days between start and end according to the convention,
+// i.e. the numerator of the year fraction. It's the actual number of days, except for
+// [Thirty360US] and [Thirty360European] which count every month as 30 days.
+//
+// Returns error if:
+//   - c is not valid: [ErrInvalidConvention]
+//   - end is before start: [ErrInvalidDates]
+func (c Convention) DayCount(start, end time.Time) (int64, error) {
+	if err := c.check(start, end); err != nil {
+		return 0, err
+	}
+
+	switch c {
+	case Thirty360US:
+		return thirty360US(start, end), nil
+	case Thirty360European:
+		return thirty360European(start, end), nil
+	default:
+		return Days(start, end), nil
+	}
+}
+
+// YearFraction returns the fraction of a year between start and end according to the convention,
+// truncated to 19 digits after the decimal point.
+//
+// Returns error if:
+//   - c is not valid: [ErrInvalidConvention]
+//   - end is before start: [ErrInvalidDates]
+//
+// Example:
+//
+//	Act360.YearFraction(2024-01-15, 2024-07-15) = 182 / 360 = 0.5055555555555555555
+//	Thirty360US.YearFraction(2024-01-15, 2024-07-15) = 180 / 360 = 0.5
+func (c Convention) YearFraction(start, end time.Time) (udecimal.Decimal, error) {
+	num, den, err := c.fraction(start, end)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	// never fails because den is not zero
+	return exact.Div(udecimal.MustFromInt64(num, 0), udecimal.MustFromInt64(den, 0))
+}
+
+// AccruedInterest returns the interest accrued on notional at the annual rate (e.g. 0.05 for 5%)
+// from start to end, i.e. notional * rate * c.YearFraction(start, end).
+//
+// notional * rate * days is computed exactly on integers, whatever the number of digits of notional and rate,
+// before dividing by the number of days in a year, so the result is only truncated once, to 19 digits after the decimal point.
+//
+// Returns error if:
+//   - c is not valid: [ErrInvalidConvention]
+//   - end is before start: [ErrInvalidDates]
+//
+// Example:
+//
+//	AccruedInterest(1000000, 0.035, 2024-01-15, 2024-07-15, Act360) = 1000000 * 0.035 * 182 / 360 = 17694.4444444444444444444
+func AccruedInterest(notional, rate udecimal.Decimal, start, end time.Time, c Convention) (udecimal.Decimal, error) {
+	num, den, err := c.fraction(start, end)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	// notional * rate can have up to 38 digits after the decimal point, more than exact keeps,
+	// so it's computed as (notional * 10^p) * (rate * 10^q) / (10^p * 10^q)
+	p, q := notional.PrecUint(), rate.PrecUint()
+
+	interest := exact.Mul(exact.Integer(notional, p), exact.Integer(rate, q))
+	interest = exact.Mul(interest, udecimal.MustFromInt64(num, 0))
+
+	scale := exact.Mul(exact.Pow10(p), exact.Pow10(q))
+	scale = exact.Mul(scale, udecimal.MustFromInt64(den, 0))
+
+	// never fails because scale is not zero
+	return exact.Div(interest, scale)
+}
+
+func (c Convention) check(start, end time.Time) error {
+	if !c.valid() {
+		return ErrInvalidConvention
+	}
+
+	if Days(start, end) < 0 {
+		return ErrInvalidDates
+	}
+
+	return nil
+}
+
+// fraction returns the year fraction between start and end as num / den
+func (c Convention) fraction(start, end time.Time) (int64, int64, error) {
+	if err := c.check(start, end); err != nil {
+		return 0, 0, err
+	}
+
+	switch c {
+	case Act360:
+		return Days(start, end), 360, nil
+	case Act365Fixed:
+		return Days(start, end), 365, nil
+	case ActActISDA:
+		// days365 / 365 + days366 / 366 = (days365 * 366 + days366 * 365) / (365 * 366)
+		days365, days366 := actualDaysByYearLength(start, end)
+		return days365*366 + days366*365, 365 * 366, nil
+	case Thirty360US:
+		return thirty360US(start, end), 360, nil
+	default:
+		return thirty360European(start, end), 360, nil
+	}
+}
+
+// actualDaysByYearLength returns the number of days between start and end in years of 365 days
+// and in leap years
+func actualDaysByYearLength(start, end time.Time) (int64, int64) {
+	var days365, days366 int64
+
+	from := civil(start)
+	to := civil(end)
+
+	for year := from.Year(); year <= to.Year(); year++ {
+		yearStart := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		nextYear := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		days := Days(maxTime(from, yearStart), minTime(to, nextYear))
+
+		if isLeap(year) {
+			days366 += days
+		} else {
+			days365 += days
+		}
+	}
+
+	return days365, days366
+}
+
+// thirty360US returns the number of days between start and end with the 30/360 US adjustments
+func thirty360US(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	if isLastDayOfFebruary(y1, m1, d1) {
+		if isLastDayOfFebruary(y2, m2, d2) {
+			d2 = 30
+		}
+
+		d1 = 30
+	}
+
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+
+	if d1 == 31 {
+		d1 = 30
+	}
+
+	return days360(y1, m1, d1, y2, m2, d2)
+}
+
+// thirty360European returns the number of days between start and end with the 30E/360 adjustments
+func thirty360European(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	return days360(y1, m1, min(d1, 30), y2, m2, min(d2, 30))
+}
+
+func days360(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) int64 {
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1))
+}
+
+func isLastDayOfFebruary(year int, month time.Month, day int) bool {
+	return month == time.February && (day == 29 || day == 28 && !isLeap(year))
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// civil returns the date of t at midnight UTC, dropping the time of day and the location
+func civil(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,188 @@
+package daycount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func date(t *testing.T, s string) time.Time {
+	t.Helper()
+
+	d, err := time.Parse(time.DateOnly, s)
+	require.NoError(t, err)
+
+	return d
+}
+
+var conventions = []Convention{Act360, Act365Fixed, ActActISDA, Thirty360US, Thirty360European}
+
+func TestYearFraction(t *testing.T) {
+	// ACT/ACT ISDA values match the examples of the OpenGamma interest rate conventions guide
+	testcases := []struct {
+		start, end string
+
+		// in the order of conventions
+		want [5]string
+	}{
+		{"2007-12-28", "2008-02-28", [5]string{"0.1722222222222222222", "0.1698630136986301369", "0.1694288494647802979", "0.1666666666666666666", "0.1666666666666666666"}},
+		{"2007-12-28", "2008-02-29", [5]string{"0.175", "0.1726027397260273972", "0.1721610899019387678", "0.1694444444444444444", "0.1694444444444444444"}},
+		{"2007-10-31", "2008-11-30", [5]string{"1.1", "1.0849315068493150684", "1.0824313197095590987", "1.0833333333333333333", "1.0833333333333333333"}},
+		{"2008-02-01", "2009-05-31", [5]string{"1.3472222222222222222", "1.3287671232876712328", "1.3262594505576764727", "1.3333333333333333333", "1.3305555555555555555"}},
+		{"2008-02-29", "2009-02-28", [5]string{"1.0138888888888888888", "1", "0.9977019237966913691", "1", "0.9972222222222222222"}},
+		{"2007-02-28", "2008-02-29", [5]string{"1.0166666666666666666", "1.0027397260273972602", "1.0022980762033086308", "1", "1.0027777777777777777"}},
+		{"2024-01-31", "2024-03-31", [5]string{"0.1666666666666666666", "0.1643835616438356164", "0.1639344262295081967", "0.1666666666666666666", "0.1666666666666666666"}},
+		{"2024-01-01", "2025-01-01", [5]string{"1.0166666666666666666", "1.0027397260273972602", "1", "1", "1"}},
+		{"2024-01-15", "2024-01-15", [5]string{"0", "0", "0", "0", "0"}},
+	}
+
+	for _, tc := range testcases {
+		for i, c := range conventions {
+			t.Run(tc.start+"/"+tc.end+"/"+c.String(), func(t *testing.T) {
+				got, err := c.YearFraction(date(t, tc.start), date(t, tc.end))
+				require.NoError(t, err)
+				require.Equal(t, tc.want[i], got.String())
+			})
+		}
+	}
+}
+
+func TestDayCount(t *testing.T) {
+	testcases := []struct {
+		start, end string
+		c          Convention
+		want       int64
+	}{
+		{"2024-01-15", "2024-07-15", Act360, 182},
+		{"2024-01-15", "2024-07-15", ActActISDA, 182},
+		{"2024-01-15", "2024-07-15", Thirty360US, 180},
+		{"2024-01-31", "2024-02-29", Thirty360US, 29},
+		{"2024-01-31", "2024-02-29", Thirty360European, 29},
+		{"2024-02-29", "2024-03-31", Thirty360US, 30},
+		{"2024-02-29", "2024-03-31", Thirty360European, 31},
+		{"2023-02-28", "2023-03-31", Thirty360US, 30},
+		{"2023-02-27", "2023-03-31", Thirty360US, 34},
+		{"2023-02-28", "2024-02-29", Thirty360US, 360},
+		{"2024-02-28", "2024-02-29", Thirty360US, 1},
+		{"2024-03-30", "2024-03-31", Thirty360US, 0},
+		{"2024-03-01", "2024-03-31", Thirty360US, 30},
+		{"2024-03-01", "2024-03-31", Thirty360European, 29},
+		{"2024-03-31", "2024-04-30", Thirty360European, 30},
+		{"2024-03-31", "2024-03-31", Thirty360European, 0},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.start+"/"+tc.end+"/"+tc.c.String(), func(t *testing.T) {
+			got, err := tc.c.DayCount(date(t, tc.start), date(t, tc.end))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDays(t *testing.T) {
+	testcases := []struct {
+		start, end string
+		want       int64
+	}{
+		{"2024-01-15", "2024-07-15", 182},
+		{"2024-07-15", "2024-01-15", -182},
+		{"2024-02-28", "2024-03-01", 2},
+		{"2023-02-28", "2023-03-01", 1},
+		{"2024-03-31", "2024-03-31", 0},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.start+"/"+tc.end, func(t *testing.T) {
+			require.Equal(t, tc.want, Days(date(t, tc.start), date(t, tc.end)))
+		})
+	}
+}
+
+func TestDatePartOnly(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// 2024-01-01 23:00 in New York is 2024-01-02 in UTC, 2024-03-10 has 23 hours because of DST
+	start := time.Date(2024, time.January, 1, 23, 0, 0, 0, ny)
+	end := time.Date(2024, time.March, 31, 0, 30, 0, 0, time.UTC)
+
+	for _, c := range conventions {
+		days, err := c.DayCount(start, end)
+		require.NoError(t, err)
+
+		if c == Thirty360European {
+			require.Equal(t, int64(89), days, c.String())
+		} else {
+			require.Equal(t, int64(90), days, c.String())
+		}
+	}
+}
+
+func TestAccruedInterest(t *testing.T) {
+	testcases := []struct {
+		notional, rate string
+		start, end     string
+		c              Convention
+		want           string
+	}{
+		{"1000000", "0.035", "2024-01-15", "2024-07-15", Act360, "17694.4444444444444444444"},
+		{"1000000", "0.035", "2024-01-15", "2024-07-15", Act365Fixed, "17452.0547945205479452054"},
+		{"1000000", "0.035", "2024-01-15", "2024-07-15", Thirty360US, "17500"},
+		{"5000000", "0.0425", "2023-12-01", "2024-02-01", ActActISDA, "36046.5790852608728198218"},
+		{"100", "0.0575", "2008-02-15", "2008-05-15", Thirty360US, "1.4375"},
+		{"-250000", "0.05", "2024-01-01", "2024-04-01", Act360, "-3159.7222222222222222222"},
+		{"1000000", "0.035", "2024-01-15", "2024-01-15", Act360, "0"},
+		{"100000000000000000000", "0.1", "2024-01-01", "2025-01-01", Act365Fixed, "10027397260273972602.7397260273972602739"},
+		// notional * rate has 20 digits after the decimal point
+		{"1.0000000001", "0.0000000001", "2024-01-01", "2033-11-09", Act360, "0.0000000010000000001"},
+		{"-1.0000000001", "0.0000000001", "2024-01-01", "2033-11-09", Act360, "-0.0000000010000000001"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.notional+"/"+tc.rate+"/"+tc.c.String(), func(t *testing.T) {
+			got, err := AccruedInterest(udecimal.MustParse(tc.notional), udecimal.MustParse(tc.rate), date(t, tc.start), date(t, tc.end), tc.c)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	start, end := date(t, "2024-01-15"), date(t, "2024-07-15")
+
+	for _, c := range conventions {
+		_, err := c.YearFraction(end, start)
+		require.ErrorIs(t, err, ErrInvalidDates)
+
+		_, err = c.DayCount(end, start)
+		require.ErrorIs(t, err, ErrInvalidDates)
+
+		_, err = AccruedInterest(udecimal.One, udecimal.One, end, start, c)
+		require.ErrorIs(t, err, ErrInvalidDates)
+	}
+
+	invalid := Thirty360European + 1
+
+	_, err := invalid.YearFraction(start, end)
+	require.ErrorIs(t, err, ErrInvalidConvention)
+
+	_, err = invalid.DayCount(start, end)
+	require.ErrorIs(t, err, ErrInvalidConvention)
+
+	_, err = AccruedInterest(udecimal.One, udecimal.One, start, end, invalid)
+	require.ErrorIs(t, err, ErrInvalidConvention)
+}
+
+func TestString(t *testing.T) {
+	require.Equal(t, "ACT/360", Act360.String())
+	require.Equal(t, "ACT/365F", Act365Fixed.String())
+	require.Equal(t, "ACT/ACT ISDA", ActActISDA.String())
+	require.Equal(t, "30/360 US", Thirty360US.String())
+	require.Equal(t, "30E/360", Thirty360European.String())
+	require.Equal(t, "Convention(5)", Convention(5).String())
+}
@@ -0,0 +1,21 @@
+// Package daycount provides the day count conventions used to compute the interest of bonds, loans and deposits,
+// such as [Act360], [Act365Fixed], [ActActISDA], [Thirty360US] and [Thirty360European].
+//
+// A convention turns 2 dates into a fraction of a year, a [udecimal.Decimal] instead of a float64,
+// which is then multiplied by the annual interest rate:
+//
+//	// 3.5% per year on 1000000 from 2024-01-15 to 2024-07-15 (182 days)
+//	daycount.AccruedInterest(notional, rate, start, end, daycount.Act360) // 17694.4444444444444444444
+//
+// # Precision
+//
+// A year fraction is computed as a single division of 2 whole numbers of days, e.g. 182 / 360,
+// and [AccruedInterest] multiplies notional * rate by the number of days before dividing,
+// so the only error is the truncation of the result to 19 digits after the decimal point.
+// Round the result to the minor units of the currency with [udecimal.Decimal.Round].
+//
+// # Dates
+//
+// Only the date part of a [time.Time], in its own location, is used. The time of day and the location
+// are ignored, e.g. 2024-01-01T23:00:00-05:00 is 2024-01-01, even though it's 2024-01-02 in UTC.
+package daycount
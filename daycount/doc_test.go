@@ -0,0 +1,43 @@
+package daycount
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/quagmt/udecimal"
+)
+
+func ExampleConvention_YearFraction() {
+	start := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.July, 15, 0, 0, 0, 0, time.UTC)
+
+	for _, c := range []Convention{Act360, Act365Fixed, ActActISDA, Thirty360US, Thirty360European} {
+		days, _ := c.DayCount(start, end)
+		fraction, _ := c.YearFraction(start, end)
+		fmt.Println(c, days, fraction)
+	}
+	// Output:
+	// ACT/360 182 0.5055555555555555555
+	// ACT/365F 182 0.4986301369863013698
+	// ACT/ACT ISDA 182 0.49726775956284153
+	// 30/360 US 180 0.5
+	// 30E/360 180 0.5
+}
+
+func ExampleAccruedInterest() {
+	notional := udecimal.MustParse("1000000")
+	rate := udecimal.MustParse("0.035")
+	start := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.July, 15, 0, 0, 0, 0, time.UTC)
+
+	interest, err := AccruedInterest(notional, rate, start, end, Act360)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(interest)
+	fmt.Println(interest.Round(2, udecimal.RoundHalfEven))
+	// Output:
+	// 17694.4444444444444444444
+	// 17694.44 <nil>
+}
@@ -20,8 +20,16 @@ var (
 	// Also such that big number (more than 200 digits) is unrealistic in financial system
 	// which this library is mainly designed for
 	maxStrLen = 200
+
+	// maxExponent is the maximum absolute value of the exponent in scientific notation (e.g. 1e1000).
+	// Any larger exponent makes the number either too large or too small to be represented
+	maxExponent = 1000
 )
 
+// maxRootIndex is the maximum root index accepted by NthRoot.
+// It bounds the size of the intermediate values (coef * 10^(n*prec)) used by the Newton-Raphson method
+const maxRootIndex = 1000
+
 // pre-computed values
 var pow10 = [39]u128{
 	{lo: 1},                                  // 10^0
@@ -105,7 +113,6 @@ var (
 	ErrMaxStrLen = fmt.Errorf("string input exceeds maximum length %d", maxStrLen)
 
 	// ErrInvalidFormat is returned when the input string is not in the correct format
-	// It doesn't support scientific notation, such as 1e-2, 1.23e4, etc.
 	ErrInvalidFormat = fmt.Errorf("invalid format")
 
 	// ErrDivideByZero is returned when dividing by zero
@@ -126,6 +133,40 @@ var (
 
 	// ErrIntPartOverflow is returned when the integer part of the decimal is too large to fit in int64
 	ErrIntPartOverflow = fmt.Errorf("integer part is too large to fit in int64")
+
+	// ErrInvalidRoundingMode is returned when the rounding mode is not one of the defined [RoundingMode] values
+	ErrInvalidRoundingMode = fmt.Errorf("invalid rounding mode")
+
+	// ErrRoundingNecessary is returned when RoundUnnecessary is used but the result can't be represented
+	// exactly with the requested precision
+	ErrRoundingNecessary = fmt.Errorf("rounding necessary. The result can't be represented exactly with the requested precision")
+
+	// ErrLogNonPositive is returned when calculating logarithm of zero or negative number
+	ErrLogNonPositive = fmt.Errorf("can't calculate logarithm of non-positive number")
+
+	// ErrExpOverflow is returned when the result of Exp or Pow is too large.
+	// The result must be less than or equal to e^414, which is the largest result that can be parsed back.
+	ErrExpOverflow = fmt.Errorf("exp overflow. The result must be less than or equal to e^%d", maxExpArg)
+
+	// ErrNegativeFractionalPow is returned when raising a negative number to a non-integer power
+	ErrNegativeFractionalPow = fmt.Errorf("can't raise negative number to a fractional power")
+
+	// ErrInvalidRootIndex is returned when the root index n of NthRoot is less than 1 or greater than 1000
+	ErrInvalidRootIndex = fmt.Errorf("invalid root index. Must be between 1 and %d", maxRootIndex)
+
+	// ErrEvenRootNegative is returned when calculating an even root of negative number
+	ErrEvenRootNegative = fmt.Errorf("can't calculate even root of negative number")
+
+	// ErrInvalidRatios is returned when Allocate is called with no ratios, a negative ratio or ratios that sum up to zero,
+	// or when Split is called with less than 1 part
+	ErrInvalidRatios = fmt.Errorf("invalid ratios. Must be non-negative and sum up to a positive number")
+
+	// ErrOverflow is returned by the checked operations, such as AddChecked, when the coefficient
+	// of the result doesn't fit in 128 bits. The returned error is an [*OverflowError] which matches ErrOverflow with errors.Is.
+	ErrOverflow = fmt.Errorf("overflow. The coefficient of the result doesn't fit in 128 bits")
+
+	// ErrInvalidOverflowMode is returned when the overflow mode of a Context is not one of the defined [OverflowMode] values
+	ErrInvalidOverflowMode = fmt.Errorf("invalid overflow mode")
 )
 
 var (
@@ -315,23 +356,25 @@ func (d Decimal) ToHiLo() (neg bool, hi uint64, lo uint64, prec uint8, ok bool)
 }
 
 // Parse parses a number in string to a decimal.
-// The string must be in the format of: [+-]d{1,19}[.d{1,19}]
+// The string must be in the format of: [+-]d+[.d{1,19}] or in scientific notation: [+-]d+[.d+](e|E)[+-]d+
+//
+// Scientific notation is normalized, e.g. 1.23e4 = 12300 and 5E-3 = 0.005.
+// Trailing zeros of the mantissa are dropped when needed, so 1.0e-19 is accepted.
 //
 // Returns error if:
 //  1. empty/invalid string
 //  2. the number has more than 19 digits after the decimal point
 //  3. string length exceeds maxStrLen (which is 200 characters. See [ErrMaxStrLen] for more details)
+//     or the number in scientific notation has more than maxStrLen digits
+//
+// The error is a [*ParseError] with the offset of the problem. It still matches
+// [ErrEmptyString], [ErrInvalidFormat], [ErrPrecOutOfRange] or [ErrMaxStrLen] with errors.Is.
 func Parse(s string) (Decimal, error) {
-	return parseBytes(unsafeStringToBytes(s))
+	return DefaultContext().Parse(s)
 }
 
 func parseBytes(b []byte) (Decimal, error) {
-	neg, bint, prec, err := parseBint(b)
-	if err != nil {
-		return Decimal{}, err
-	}
-
-	return newDecimal(neg, bint, prec), nil
+	return DefaultContext().parseBytes(b)
 }
 
 // MustParse similars to Parse, but pacnis instead of returning error.
@@ -472,10 +515,15 @@ func (d Decimal) Sub64(e uint64) Decimal {
 // Mul returns d * e.
 // The result will have at most defaultPrec digits after the decimal point.
 func (d Decimal) Mul(e Decimal) Decimal {
+	return d.mul(e, defaultPrec)
+}
+
+// mul returns d * e, truncated to precLimit digits after the decimal point
+func (d Decimal) mul(e Decimal, precLimit uint8) Decimal {
 	prec := d.prec + e.prec
 	neg := d.neg != e.neg
 
-	v, err := tryMulU128(d, e, neg, prec)
+	v, err := tryMulU128(d, e, neg, prec, precLimit)
 	if err == nil {
 		return v
 	}
@@ -485,21 +533,21 @@ func (d Decimal) Mul(e Decimal) Decimal {
 	eBig := e.coef.GetBig()
 
 	dBig.Mul(dBig, eBig)
-	if prec <= defaultPrec {
+	if prec <= precLimit {
 		return newDecimal(neg, bintFromBigInt(dBig), prec)
 	}
 
-	q, _ := new(big.Int).QuoRem(dBig, pow10[prec-defaultPrec].ToBigInt(), new(big.Int))
-	return newDecimal(neg, bintFromBigInt(q), defaultPrec)
+	q, _ := new(big.Int).QuoRem(dBig, pow10[prec-precLimit].ToBigInt(), new(big.Int))
+	return newDecimal(neg, bintFromBigInt(q), precLimit)
 }
 
-func tryMulU128(d, e Decimal, neg bool, prec uint8) (Decimal, error) {
+func tryMulU128(d, e Decimal, neg bool, prec, precLimit uint8) (Decimal, error) {
 	if d.coef.overflow() || e.coef.overflow() {
 		return Decimal{}, errOverflow
 	}
 
 	rcoef := d.coef.u128.MulToU256(e.coef.u128)
-	if prec <= defaultPrec {
+	if prec <= precLimit {
 		if !rcoef.carry.IsZero() {
 			return Decimal{}, errOverflow
 		}
@@ -508,12 +556,12 @@ func tryMulU128(d, e Decimal, neg bool, prec uint8) (Decimal, error) {
 		return newDecimal(neg, bintFromU128(coef), prec), nil
 	}
 
-	q, _, err := rcoef.fastQuo(pow10[prec-defaultPrec])
+	q, _, err := rcoef.fastQuo(pow10[prec-precLimit])
 	if err != nil {
 		return Decimal{}, err
 	}
 
-	return newDecimal(neg, bintFromU128(q), defaultPrec), nil
+	return newDecimal(neg, bintFromU128(q), precLimit), nil
 }
 
 // Mul64 returns d * e where e is a uint64.
@@ -546,20 +594,30 @@ func (d Decimal) Mul64(v uint64) Decimal {
 //
 // Returns divide by zero error when e is zero
 func (d Decimal) Div(e Decimal) (Decimal, error) {
+	return DefaultContext().Div(d, e)
+}
+
+func (d Decimal) div(e Decimal, prec uint8) (Decimal, error) {
 	if e.coef.IsZero() {
 		return Decimal{}, ErrDivideByZero
 	}
 
+	// Digits of d beyond prec + e.prec can't affect the truncated result,
+	// so drop them to make sure the factor below is never negative
+	if d.prec > prec+e.prec {
+		d = d.Trunc(prec + e.prec)
+	}
+
 	neg := d.neg != e.neg
 
-	q, err := tryDivU128(d, e, neg)
+	q, err := tryDivU128(d, e, neg, prec)
 	if err == nil {
 		return q, nil
 	}
 
 	// Need to multiply divident with factor
-	// to make sure the total decimal number after the decimal point is defaultPrec
-	factor := defaultPrec - (d.prec - e.prec)
+	// to make sure the total decimal number after the decimal point is prec
+	factor := prec + e.prec - d.prec
 
 	// overflow, try with *big.Int
 	dBig := d.coef.GetBig()
@@ -567,17 +625,17 @@ func (d Decimal) Div(e Decimal) (Decimal, error) {
 
 	dBig.Mul(dBig, pow10[factor].ToBigInt())
 	dBig.Div(dBig, eBig)
-	return newDecimal(neg, bintFromBigInt(dBig), defaultPrec), nil
+	return newDecimal(neg, bintFromBigInt(dBig), prec), nil
 }
 
-func tryDivU128(d, e Decimal, neg bool) (Decimal, error) {
+func tryDivU128(d, e Decimal, neg bool, prec uint8) (Decimal, error) {
 	if d.coef.overflow() || e.coef.overflow() {
 		return Decimal{}, errOverflow
 	}
 
 	// Need to multiply divident with factor
-	// to make sure the total decimal number after the decimal point is defaultPrec
-	factor := defaultPrec - (d.prec - e.prec)
+	// to make sure the total decimal number after the decimal point is prec
+	factor := prec + e.prec - d.prec
 
 	d256 := d.coef.u128.MulToU256(pow10[factor])
 	quo, _, err := d256.fastQuo(e.coef.u128)
@@ -585,7 +643,7 @@ func tryDivU128(d, e Decimal, neg bool) (Decimal, error) {
 		return Decimal{}, err
 	}
 
-	return newDecimal(neg, bintFromU128(quo), defaultPrec), nil
+	return newDecimal(neg, bintFromU128(quo), prec), nil
 }
 
 // Div64 returns d / e where e is a uint64.
@@ -593,19 +651,27 @@ func tryDivU128(d, e Decimal, neg bool) (Decimal, error) {
 //
 // Returns divide by zero error when e is zero
 func (d Decimal) Div64(v uint64) (Decimal, error) {
+	return DefaultContext().Div64(d, v)
+}
+
+func (d Decimal) div64(v uint64, prec uint8) (Decimal, error) {
 	if v == 0 {
 		return Decimal{}, ErrDivideByZero
 	}
 
+	if d.prec > prec {
+		d = d.Trunc(prec)
+	}
+
 	if v == 1 {
 		return d, nil
 	}
 
 	if !d.coef.overflow() {
-		d256 := d.coef.u128.MulToU256(pow10[defaultPrec-d.prec])
+		d256 := d.coef.u128.MulToU256(pow10[prec-d.prec])
 		quo, _, err := d256.div192by64(v)
 		if err == nil {
-			return newDecimal(d.neg, bintFromU128(quo), defaultPrec), nil
+			return newDecimal(d.neg, bintFromU128(quo), prec), nil
 		}
 
 		// overflow, try with *big.Int
@@ -613,10 +679,167 @@ func (d Decimal) Div64(v uint64) (Decimal, error) {
 
 	// overflow, try with *big.Int
 	dBig := d.coef.GetBig()
-	dBig.Mul(dBig, pow10[defaultPrec-d.prec].ToBigInt())
+	dBig.Mul(dBig, pow10[prec-d.prec].ToBigInt())
 	dBig.Div(dBig, new(big.Int).SetUint64(v))
 
-	return newDecimal(d.neg, bintFromBigInt(dBig), defaultPrec), nil
+	return newDecimal(d.neg, bintFromBigInt(dBig), prec), nil
+}
+
+// DivRound returns d / e rounded to prec digits after the decimal point using the given rounding mode.
+//
+// The quotient is rounded once using the exact remainder of the division. It's different from
+// calling [Decimal.Div] and then rounding the result, which rounds twice and can give a wrong result
+// when the truncated quotient ends with a half (e.g. 0.125 truncated from 0.1250000000000000000001).
+//
+// Returns error if:
+//   - e is zero
+//   - prec > 19
+//   - mode is not a valid [RoundingMode]
+//   - mode is [RoundUnnecessary] and the quotient can't be represented exactly with prec digits
+//
+// Examples:
+//
+//	DivRound(1, 3, 2, RoundHalfEven) = 0.33
+//	DivRound(1, 8, 2, RoundHalfEven) = 0.12
+//	DivRound(1, 8, 2, RoundHalfUp) = 0.13
+//	DivRound(-2, 3, 2, RoundFloor) = -0.67
+func (d Decimal) DivRound(e Decimal, prec uint8, mode RoundingMode) (Decimal, error) {
+	if prec > maxPrec {
+		return Decimal{}, ErrPrecOutOfRange
+	}
+
+	return d.divRound(e, prec, mode)
+}
+
+func (d Decimal) divRound(e Decimal, prec uint8, mode RoundingMode) (Decimal, error) {
+	if e.coef.IsZero() {
+		return Decimal{}, ErrDivideByZero
+	}
+
+	if !mode.valid() {
+		return Decimal{}, ErrInvalidRoundingMode
+	}
+
+	neg := d.neg != e.neg
+
+	q, err := tryDivRoundU128(d, e, neg, prec, mode)
+	if err == nil {
+		return q, nil
+	}
+
+	// overflow, try with *big.Int
+	dBig := d.coef.GetBig()
+	eBig := e.coef.GetBig()
+
+	// d / e = (d.coef * 10^factor) / e.coef * 10^(-prec)
+	// if factor is negative, multiply the divisor instead to keep the exact remainder
+	factor := int(prec) + int(e.prec) - int(d.prec)
+	if factor >= 0 {
+		dBig.Mul(dBig, pow10[factor].ToBigInt())
+	} else {
+		eBig.Mul(eBig, pow10[-factor].ToBigInt())
+	}
+
+	qBig, rBig := new(big.Int).QuoRem(dBig, eBig, new(big.Int))
+	if rBig.Sign() != 0 && mode == RoundUnnecessary {
+		return Decimal{}, ErrRoundingNecessary
+	}
+
+	if rBig.Sign() != 0 && mode.roundUp(neg, qBig.Bit(0) == 1, rBig.Cmp(eBig.Sub(eBig, rBig))) {
+		qBig.Add(qBig, bigOne)
+	}
+
+	return newDecimal(neg, bintFromBigInt(qBig), prec), nil
+}
+
+func tryDivRoundU128(d, e Decimal, neg bool, prec uint8, mode RoundingMode) (Decimal, error) {
+	if d.coef.overflow() || e.coef.overflow() {
+		return Decimal{}, errOverflow
+	}
+
+	var (
+		d256 u256
+		e128 = e.coef.u128
+		err  error
+	)
+
+	// d / e = (d.coef * 10^factor) / e.coef * 10^(-prec)
+	// if factor is negative, multiply the divisor instead to keep the exact remainder
+	factor := int(prec) + int(e.prec) - int(d.prec)
+	if factor >= 0 {
+		d256 = d.coef.u128.MulToU256(pow10[factor])
+	} else {
+		d256 = u256{hi: d.coef.u128.hi, lo: d.coef.u128.lo}
+
+		e128, err = e128.Mul(pow10[-factor])
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	q, r, err := d256.fastQuo(e128)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	if !r.IsZero() && mode == RoundUnnecessary {
+		return Decimal{}, ErrRoundingNecessary
+	}
+
+	// compare r with e128 - r instead of 2*r with e128 to avoid overflow
+	if !r.IsZero() && mode.roundUp(neg, q.lo&1 == 1, r.Cmp(subUnsafe(e128, r))) {
+		q, err = q.Add64(1)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	return newDecimal(neg, bintFromU128(q), prec), nil
+}
+
+// Div64Round returns d / v rounded to prec digits after the decimal point using the given rounding mode,
+// where v is a uint64. See [Decimal.DivRound] for more details.
+//
+// Returns error if:
+//   - v is zero
+//   - prec > 19
+//   - mode is not a valid [RoundingMode]
+//   - mode is [RoundUnnecessary] and the quotient can't be represented exactly with prec digits
+func (d Decimal) Div64Round(v uint64, prec uint8, mode RoundingMode) (Decimal, error) {
+	if prec > maxPrec {
+		return Decimal{}, ErrPrecOutOfRange
+	}
+
+	if v == 0 {
+		return Decimal{}, ErrDivideByZero
+	}
+
+	if !mode.valid() {
+		return Decimal{}, ErrInvalidRoundingMode
+	}
+
+	if !d.coef.overflow() && d.prec <= prec {
+		d256 := d.coef.u128.MulToU256(pow10[prec-d.prec])
+		q, r, err := d256.div192by64(v)
+		if err == nil {
+			if r != 0 && mode == RoundUnnecessary {
+				return Decimal{}, ErrRoundingNecessary
+			}
+
+			// compare r with v - r instead of 2*r with v to avoid overflow
+			half := u128{lo: r}.Cmp64(v - r)
+			if r != 0 && mode.roundUp(d.neg, q.lo&1 == 1, half) {
+				q, err = q.Add64(1)
+			}
+
+			if err == nil {
+				return newDecimal(d.neg, bintFromU128(q), prec), nil
+			}
+		}
+	}
+
+	// d has more digits than prec or overflow, use the general division
+	return d.divRound(newDecimal(false, bintFromU64(v), 0), prec, mode)
 }
 
 // QuoRem returns q and r where
@@ -696,6 +919,23 @@ func (d Decimal) Mod(e Decimal) (Decimal, error) {
 	return r, err
 }
 
+// IsMultipleOf reports whether d is an integer multiple of step, i.e. d = k * step for some integer k.
+// Only zero is a multiple of zero.
+//
+// Examples:
+//
+//	IsMultipleOf(1.25, 0.05) = true
+//	IsMultipleOf(1.23, 0.05) = false
+//	IsMultipleOf(-0.003, 0.001) = true
+func (d Decimal) IsMultipleOf(step Decimal) bool {
+	if step.coef.IsZero() {
+		return d.coef.IsZero()
+	}
+
+	_, r, err := d.QuoRem(step)
+	return err == nil && r.coef.IsZero()
+}
+
 // Prec returns decimal precision as an integer
 func (d Decimal) Prec() int {
 	return int(d.prec)
@@ -913,50 +1153,98 @@ func (d Decimal) IsPos() bool {
 	return !d.neg && !d.coef.IsZero()
 }
 
-// RoundBank uses half up to even (banker's rounding) to round the decimal to the specified prec.
+// Round rounds the decimal to the specified prec using the given rounding mode.
+// If d has prec or fewer digits after the decimal point, d is returned unchanged.
+//
+// Returns error if:
+//   - mode is not a valid [RoundingMode]
+//   - mode is [RoundUnnecessary] and d has non-zero digits after prec
 //
 // Examples:
 //
-//	RoundBank(1.12345, 4) = 1.1234
-//	RoundBank(1.12335, 4) = 1.1234
-//	RoundBank(1.5, 0) = 2
-//	RoundBank(-1.5, 0) = -2
-func (d Decimal) RoundBank(prec uint8) Decimal {
+//	Round(1.125, 2, RoundHalfEven) = 1.12
+//	Round(1.125, 2, RoundHalfUp) = 1.13
+//	Round(-1.125, 2, RoundHalfCeiling) = -1.12
+//	Round(-1.121, 2, RoundFloor) = -1.13
+//	Round(1.120, 2, RoundUnnecessary) = 1.12
+//	Round(1.125, 2, RoundUnnecessary) = ErrRoundingNecessary
+func (d Decimal) Round(prec uint8, mode RoundingMode) (Decimal, error) {
+	if !mode.valid() {
+		return Decimal{}, ErrInvalidRoundingMode
+	}
+
+	return d.round(prec, mode)
+}
+
+// round rounds d to prec digits using mode, which must be valid.
+// The only possible error is ErrRoundingNecessary when mode is RoundUnnecessary.
+func (d Decimal) round(prec uint8, mode RoundingMode) (Decimal, error) {
 	if prec >= d.prec {
-		return d
+		return d, nil
 	}
 
-	factor := pow10[d.prec-prec]
-	lo := factor.lo / 2
+	// factor <= 10^19 always fits in uint64
+	factor := pow10[d.prec-prec].lo
 
 	if !d.coef.overflow() {
+		q, r := d.coef.u128.QuoRem64(factor)
+		if r == 0 {
+			return newDecimal(d.neg, bintFromU128(q), prec), nil
+		}
+
+		if mode == RoundUnnecessary {
+			return Decimal{}, ErrRoundingNecessary
+		}
+
 		var err error
-		q, r := d.coef.u128.QuoRem64(factor.lo)
-		if lo < r || (lo == r && q.lo%2 == 1) {
+
+		// compare r with factor - r instead of 2*r with factor to avoid overflow
+		if mode.roundUp(d.neg, q.lo&1 == 1, u128{lo: r}.Cmp64(factor-r)) {
 			q, err = q.Add64(1)
 		}
 
 		// no overflow, return the result
 		if err == nil {
-			return newDecimal(d.neg, bintFromU128(q), prec)
+			return newDecimal(d.neg, bintFromU128(q), prec), nil
 		}
 	}
 
 	// overflow, fallback to big.Int
 	dBig := d.coef.GetBig()
-	q, r := new(big.Int).QuoRem(dBig, factor.ToBigInt(), new(big.Int))
+	factorBig := new(big.Int).SetUint64(factor)
+	q, r := new(big.Int).QuoRem(dBig, factorBig, new(big.Int))
+
+	if r.Sign() != 0 {
+		if mode == RoundUnnecessary {
+			return Decimal{}, ErrRoundingNecessary
+		}
 
-	loBig := new(big.Int).SetUint64(lo)
-	if r.Cmp(loBig) > 0 || (r.Cmp(loBig) == 0 && q.Bit(0) == 1) {
-		q.Add(q, bigOne)
+		if mode.roundUp(d.neg, q.Bit(0) == 1, r.Cmp(factorBig.Sub(factorBig, r))) {
+			q.Add(q, bigOne)
+		}
 	}
 
-	return newDecimal(d.neg, bintFromBigInt(q), prec)
+	return newDecimal(d.neg, bintFromBigInt(q), prec), nil
+}
+
+// RoundBank uses half up to even (banker's rounding) to round the decimal to the specified prec.
+// It's equivalent to Round(prec, RoundHalfEven).
+//
+// Examples:
+//
+//	RoundBank(1.12345, 4) = 1.1234
+//	RoundBank(1.12335, 4) = 1.1234
+//	RoundBank(1.5, 0) = 2
+//	RoundBank(-1.5, 0) = -2
+func (d Decimal) RoundBank(prec uint8) Decimal {
+	q, _ := d.round(prec, RoundHalfEven)
+	return q
 }
 
 // RoundAwayFromZero rounds the decimal to the specified prec using AWAY FROM ZERO method (https://en.wikipedia.org/wiki/Rounding#Rounding_away_from_zero).
 // If differs from HALF AWAY FROM ZERO in a way that the number is always rounded away from zero (or to infinity) no matter if is 0.5 or not.
 // In other libraries or languages, this method is also known as ROUND_UP.
+// It's equivalent to Round(prec, RoundUp).
 //
 // Examples:
 //
@@ -965,37 +1253,12 @@ func (d Decimal) RoundBank(prec uint8) Decimal {
 //	Round(-1.12, 1) = -1.12
 //	Round(-1.15, 1) = -1.12
 func (d Decimal) RoundAwayFromZero(prec uint8) Decimal {
-	if prec >= d.prec {
-		return d
-	}
-
-	factor := pow10[d.prec-prec]
-
-	if !d.coef.overflow() {
-		var err error
-		q, r := d.coef.u128.QuoRem64(factor.lo)
-
-		if r != 0 {
-			q, err = q.Add64(1)
-		}
-
-		if err == nil {
-			return newDecimal(d.neg, bintFromU128(q), prec)
-		}
-	}
-
-	// overflow, fallback to big.Int
-	dBig := d.coef.GetBig()
-	q, r := new(big.Int).QuoRem(dBig, factor.ToBigInt(), new(big.Int))
-
-	if r.Cmp(bigZero) != 0 {
-		q.Add(q, bigOne)
-	}
-
-	return newDecimal(d.neg, bintFromBigInt(q), prec)
+	q, _ := d.round(prec, RoundUp)
+	return q
 }
 
 // RoundHAZ rounds the decimal to the specified prec using HALF AWAY FROM ZERO method (https://en.wikipedia.org/wiki/Rounding#Rounding_half_away_from_zero).
+// It's equivalent to Round(prec, RoundHalfUp).
 //
 // Examples:
 //
@@ -1004,38 +1267,12 @@ func (d Decimal) RoundAwayFromZero(prec uint8) Decimal {
 //	Round(1.5, 0) = 2
 //	Round(-1.5, 0) = -2
 func (d Decimal) RoundHAZ(prec uint8) Decimal {
-	if prec >= d.prec {
-		return d
-	}
-
-	factor := pow10[d.prec-prec]
-	half, _ := factor.QuoRem64(2)
-
-	if !d.coef.overflow() {
-		var err error
-		q, r := d.coef.u128.QuoRem64(factor.lo)
-		if half.Cmp64(r) <= 0 {
-			q, err = q.Add64(1)
-		}
-
-		if err == nil {
-			return newDecimal(d.neg, bintFromU128(q), prec)
-		}
-	}
-
-	// overflow, fallback to big.Int
-	dBig := d.coef.GetBig()
-	q, r := new(big.Int).QuoRem(dBig, factor.ToBigInt(), new(big.Int))
-
-	loBig := half.ToBigInt()
-	if r.Cmp(loBig) >= 0 {
-		q.Add(q, bigOne)
-	}
-
-	return newDecimal(d.neg, bintFromBigInt(q), prec)
+	q, _ := d.round(prec, RoundHalfUp)
+	return q
 }
 
 // RoundHTZ rounds the decimal to the specified prec using HALF TOWARD ZERO method (https://en.wikipedia.org/wiki/Rounding#Rounding_half_toward_zero).
+// It's equivalent to Round(prec, RoundHalfDown).
 //
 // Examples:
 //
@@ -1044,99 +1281,102 @@ func (d Decimal) RoundHAZ(prec uint8) Decimal {
 //	Round(1.5, 0) = 1
 //	Round(-1.5, 0) = -1
 func (d Decimal) RoundHTZ(prec uint8) Decimal {
-	if prec >= d.prec {
-		return d
-	}
-
-	factor := pow10[d.prec-prec]
-	half, _ := factor.QuoRem64(2)
-
-	if !d.coef.overflow() {
-		var err error
-		q, r := d.coef.u128.QuoRem64(factor.lo)
-		if half.Cmp64(r) < 0 {
-			q, err = q.Add64(1)
-		}
-
-		if err == nil {
-			return newDecimal(d.neg, bintFromU128(q), prec)
-		}
-	}
-
-	// overflow, fallback to big.Int
-	dBig := d.coef.GetBig()
-	q, r := new(big.Int).QuoRem(dBig, factor.ToBigInt(), new(big.Int))
-
-	loBig := half.ToBigInt()
-	if r.Cmp(loBig) > 0 {
-		q.Add(q, bigOne)
-	}
-
-	return newDecimal(d.neg, bintFromBigInt(q), prec)
+	q, _ := d.round(prec, RoundHalfDown)
+	return q
 }
 
 // Floor returns the largest integer value less than or equal to d.
 func (d Decimal) Floor() Decimal {
-	if d.prec == 0 {
-		return d
-	}
+	q, _ := d.round(0, RoundFloor)
+	return q
+}
 
-	if !d.coef.overflow() {
-		var err error
-		q, r := d.coef.u128.QuoRem64(pow10[d.prec].lo)
+// Ceil returns the smallest integer value greater than or equal to d.
+func (d Decimal) Ceil() Decimal {
+	q, _ := d.round(0, RoundCeiling)
+	return q
+}
 
-		// add 1 if it's negative and there's a remainder, e.g. -1.5 -> -2
-		if d.neg && r != 0 {
-			q, err = q.Add64(1)
-		}
+// FloorPrec returns the largest value less than or equal to d with at most prec digits after the decimal point.
+// It's equivalent to Round(prec, RoundFloor).
+//
+// Examples:
+//
+//	FloorPrec(1.129, 2) = 1.12
+//	FloorPrec(-1.121, 2) = -1.13
+//	FloorPrec(1.5, 0) = 1
+func (d Decimal) FloorPrec(prec uint8) Decimal {
+	q, _ := d.round(prec, RoundFloor)
+	return q
+}
 
-		if err == nil {
-			return newDecimal(d.neg, bintFromU128(q), 0)
-		}
+// CeilPrec returns the smallest value greater than or equal to d with at most prec digits after the decimal point.
+// It's equivalent to Round(prec, RoundCeiling).
+//
+// Examples:
+//
+//	CeilPrec(1.121, 2) = 1.13
+//	CeilPrec(-1.129, 2) = -1.12
+//	CeilPrec(1.5, 0) = 2
+func (d Decimal) CeilPrec(prec uint8) Decimal {
+	q, _ := d.round(prec, RoundCeiling)
+	return q
+}
+
+// RoundToIncrement rounds d to a multiple of step using the given rounding mode,
+// e.g. to the tick size of a price or the lot step of a quantity.
+// The sign of step is ignored. The rounding mode is applied to d / step, so the result
+// is the multiple of step that the rounded quotient points to.
+//
+// Returns error if:
+//   - step is zero
+//   - mode is not a valid [RoundingMode]
+//   - mode is [RoundUnnecessary] and d is not a multiple of step
+//
+// Examples:
+//
+//	RoundToIncrement(1.23, 0.05, RoundHalfUp) = 1.25
+//	RoundToIncrement(1.225, 0.05, RoundHalfEven) = 1.2
+//	RoundToIncrement(-1.23, 0.25, RoundFloor) = -1.25
+//	RoundToIncrement(0.12345, 0.001, RoundDown) = 0.123
+func (d Decimal) RoundToIncrement(step Decimal, mode RoundingMode) (Decimal, error) {
+	if step.coef.IsZero() {
+		return Decimal{}, ErrDivideByZero
 	}
 
-	// overflow, fallback to big.Int
-	dBig := d.coef.GetBig()
-	q, r := new(big.Int).QuoRem(dBig, pow10[d.prec].ToBigInt(), new(big.Int))
-
-	// add 1 if it's negative and there's a remainder, e.g. -1.5 -> -2
-	if d.neg && r.Cmp(bigZero) != 0 {
-		q.Add(q, bigOne)
+	if !mode.valid() {
+		return Decimal{}, ErrInvalidRoundingMode
 	}
 
-	return newDecimal(d.neg, bintFromBigInt(q), 0)
-}
+	step = step.Abs()
 
-// Ceil returns the smallest integer value greater than or equal to d.
-func (d Decimal) Ceil() Decimal {
-	if d.prec == 0 {
-		return d
+	// q and r have the same sign as d, so d - r is the multiple of step truncated toward zero
+	q, r, err := d.QuoRem(step)
+	if err != nil {
+		return Decimal{}, err
 	}
 
-	if !d.coef.overflow() {
-		var err error
-		q, r := d.coef.u128.QuoRem64(pow10[d.prec].lo)
-
-		// add 1 if it's positive and there's a remainder, e.g. 1.5 -> 2
-		if !d.neg && r != 0 {
-			q, err = q.Add64(1)
-		}
+	if r.coef.IsZero() {
+		return d, nil
+	}
 
-		if err == nil {
-			return newDecimal(d.neg, bintFromU128(q), 0)
-		}
+	if mode == RoundUnnecessary {
+		return Decimal{}, ErrRoundingNecessary
 	}
 
-	// overflow, fallback to big.Int
-	dBig := d.coef.GetBig()
-	q, r := new(big.Int).QuoRem(dBig, pow10[d.prec].ToBigInt(), new(big.Int))
+	res := d.Sub(r)
 
-	// add 1 if it's positive and there's a remainder, e.g. 1.5 -> 2
-	if !d.neg && r.Cmp(bigZero) != 0 {
-		q.Add(q, bigOne)
+	// compare |r| with step - |r| instead of 2*|r| with step
+	r = r.Abs()
+	if mode.roundUp(d.neg, q.coef.isOdd(), r.Cmp(step.Sub(r))) {
+		if d.neg {
+			res = res.Sub(step)
+		} else {
+			res = res.Add(step)
+		}
 	}
 
-	return newDecimal(d.neg, bintFromBigInt(q), 0)
+	return res, nil
 }
 
 // Trunc returns d after truncating the decimal to the specified prec.
@@ -1320,6 +1560,10 @@ func trailingZerosU128(n u128) uint8 {
 //	PowInt32(2.5, 2.6) = 2.5^2 = 6.25
 //	PowInt32(2.5, -2.123) = 2.5^(-2) = 0.16
 func (d Decimal) PowToIntPart(e Decimal) (Decimal, error) {
+	return DefaultContext().PowToIntPart(d, e)
+}
+
+func (d Decimal) powToIntPart(e Decimal, prec uint8) (Decimal, error) {
 	if d.coef.IsZero() && e.neg {
 		return Decimal{}, ErrZeroPowNegative
 	}
@@ -1339,7 +1583,7 @@ func (d Decimal) PowToIntPart(e Decimal) (Decimal, error) {
 		exponent = -exponent
 	}
 
-	return d.PowInt32(exponent)
+	return d.powInt32(exponent, prec)
 }
 
 // Deprecated: Use [PowInt32] instead for correct handling of 0^0 and negative exponents.
@@ -1378,11 +1622,11 @@ func (d Decimal) PowInt(e int) Decimal {
 	dTrim := d.trimTrailingZeros()
 
 	if e < 0 {
-		return dTrim.powIntInverse(-e)
+		return dTrim.powIntInverse(-e, defaultPrec)
 	}
 
 	// e > 1 && d != 0
-	q, err := dTrim.tryPowIntU128(e)
+	q, err := dTrim.tryPowIntU128(e, defaultPrec)
 	if err == nil {
 		return q
 	}
@@ -1428,6 +1672,10 @@ func (d Decimal) PowInt(e int) Decimal {
 //	PowInt32(2.5, 2) = 6.25
 //	PowInt32(2.5, -2) = 0.16
 func (d Decimal) PowInt32(e int32) (Decimal, error) {
+	return DefaultContext().PowInt32(d, e)
+}
+
+func (d Decimal) powInt32(e int32, prec uint8) (Decimal, error) {
 	// special case: 0 raised to a negative power
 	if d.coef.IsZero() && e < 0 {
 		return Decimal{}, ErrZeroPowNegative
@@ -1438,18 +1686,18 @@ func (d Decimal) PowInt32(e int32) (Decimal, error) {
 	}
 
 	if e == 1 {
-		return d, nil
+		return d.Trunc(prec), nil
 	}
 
 	// Rescale first to remove trailing zeros
 	dTrim := d.trimTrailingZeros()
 
 	if e < 0 {
-		return dTrim.powIntInverse(int(-e)), nil
+		return dTrim.powIntInverse(int(-e), prec), nil
 	}
 
 	// e > 1 && d != 0
-	q, err := dTrim.tryPowIntU128(int(e))
+	q, err := dTrim.tryPowIntU128(int(e), prec)
 	if err == nil {
 		return q, nil
 	}
@@ -1459,9 +1707,9 @@ func (d Decimal) PowInt32(e int32) (Decimal, error) {
 
 	var factor int32
 	powPrecision := int32(dTrim.prec) * e
-	if powPrecision >= int32(defaultPrec) {
-		factor = powPrecision - int32(defaultPrec)
-		powPrecision = int32(defaultPrec)
+	if powPrecision >= int32(prec) {
+		factor = powPrecision - int32(prec)
+		powPrecision = int32(prec)
 	}
 
 	m := new(big.Int).Exp(bigTen, big.NewInt(int64(factor)), nil)
@@ -1473,13 +1721,13 @@ func (d Decimal) PowInt32(e int32) (Decimal, error) {
 		neg = false
 	}
 
-	//nolint:gosec // powPrecision <= defaultPrec, so it's safe to convert to uint8
+	//nolint:gosec // powPrecision <= prec, so it's safe to convert to uint8
 	return newDecimal(neg, bintFromBigInt(qBig), uint8(powPrecision)), nil
 }
 
 // powIntInverse returns d^(-e), with e > 0
-func (d Decimal) powIntInverse(e int) Decimal {
-	q, err := d.tryInversePowIntU128(e)
+func (d Decimal) powIntInverse(e int, prec uint8) Decimal {
+	q, err := d.tryInversePowIntU128(e, prec)
 	if err == nil {
 		return q
 	}
@@ -1488,8 +1736,8 @@ func (d Decimal) powIntInverse(e int) Decimal {
 	dBig := d.coef.GetBig()
 	powPrecision := int(d.prec) * e
 
-	// d^(-e) = 10^(defaultPrec + e) / d^e (with defaultPrec digits after the decimal point)
-	m := new(big.Int).Exp(bigTen, big.NewInt(int64(powPrecision+int(defaultPrec))), nil)
+	// d^(-e) = 10^(prec + e) / d^e (with prec digits after the decimal point)
+	m := new(big.Int).Exp(bigTen, big.NewInt(int64(powPrecision+int(prec))), nil)
 	dBig = new(big.Int).Exp(dBig, big.NewInt(int64(e)), nil)
 	qBig := dBig.Quo(m, dBig)
 
@@ -1498,10 +1746,10 @@ func (d Decimal) powIntInverse(e int) Decimal {
 		neg = false
 	}
 
-	return newDecimal(neg, bintFromBigInt(qBig), defaultPrec)
+	return newDecimal(neg, bintFromBigInt(qBig), prec)
 }
 
-func (d Decimal) tryPowIntU128(e int) (Decimal, error) {
+func (d Decimal) tryPowIntU128(e int, prec uint8) (Decimal, error) {
 	if d.coef.overflow() {
 		return Decimal{}, errOverflow
 	}
@@ -1518,8 +1766,8 @@ func (d Decimal) tryPowIntU128(e int) (Decimal, error) {
 	}
 
 	exponent := int(d.prec) * e
-	if exponent > int(defaultPrec)+38 {
-		// we can't do adjustment if exponent > defaultPrec + 38 (can't find pow10[exponent - defaultPrec])
+	if exponent > int(prec)+38 {
+		// we can't do adjustment if exponent > prec + 38 (can't find pow10[exponent - prec])
 		return Decimal{}, errOverflow
 	}
 
@@ -1529,27 +1777,27 @@ func (d Decimal) tryPowIntU128(e int) (Decimal, error) {
 		return Decimal{}, err
 	}
 
-	// exponent <= defaultPrec, no need to adjust the result
-	if exponent <= int(defaultPrec) {
+	// exponent <= prec, no need to adjust the result
+	if exponent <= int(prec) {
 		if !result.carry.IsZero() {
 			return Decimal{}, errOverflow
 		}
 
-		//nolint:gosec // exponent <= defaultPrec, so it's safe to convert to uint8
+		//nolint:gosec // exponent <= prec, so it's safe to convert to uint8
 		return newDecimal(neg, bintFromU128(u128{hi: result.hi, lo: result.lo}), uint8(exponent)), nil
 	}
 
-	// exponent > defaultPrec, adjust the result to u128 by dividing it with 10^(exponent - defaultPrec)
-	factor := exponent - int(defaultPrec)
+	// exponent > prec, adjust the result to u128 by dividing it with 10^(exponent - prec)
+	factor := exponent - int(prec)
 	q, _, err := result.fastQuo(pow10[factor]) // it's safe to use pow10[factor] as factor <= 38 (conditional check above)
 	if err != nil {
 		return Decimal{}, err
 	}
 
-	return newDecimal(neg, bintFromU128(q), defaultPrec), nil
+	return newDecimal(neg, bintFromU128(q), prec), nil
 }
 
-func (d Decimal) tryInversePowIntU128(e int) (Decimal, error) {
+func (d Decimal) tryInversePowIntU128(e int, prec uint8) (Decimal, error) {
 	if d.coef.overflow() {
 		return Decimal{}, errOverflow
 	}
@@ -1565,15 +1813,15 @@ func (d Decimal) tryInversePowIntU128(e int) (Decimal, error) {
 		neg = false
 	}
 
-	// d^(-e) = 10^(defaultPrec + d.prec * e) / (d.coef)^e (with defaultPrec digits after the decimal point)
-	// let exponent = defaultPrec + d.prec * e and B = (d.coef)^e
+	// d^(-e) = 10^(prec + d.prec * e) / (d.coef)^e (with prec digits after the decimal point)
+	// let exponent = prec + d.prec * e and B = (d.coef)^e
 	// --> d^(-e) = 10^exponent / B
 	// Can only use fastQuo if 10^exponent < 2^256 and B < 2^128
-	// --> defaultPrec + d.prec * e < log10(2) * 256 != 77
+	// --> prec + d.prec * e < log10(2) * 256 != 77
 	//
 	// Choose exponent <= 76 so if exponent > 38, it's safe to use 10^exponent = pow10[exponent - 38] * pow10[38]
 	// as 0 < exponent - 38 <= 38 and max(pow10) = 10^38
-	exponent := int(d.prec)*e + int(defaultPrec)
+	exponent := int(d.prec)*e + int(prec)
 	if exponent > 76 {
 		return Decimal{}, errOverflow
 	}
@@ -1595,7 +1843,7 @@ func (d Decimal) tryInversePowIntU128(e int) (Decimal, error) {
 			return Decimal{}, err
 		}
 
-		return newDecimal(neg, bintFromU128(q), defaultPrec), nil
+		return newDecimal(neg, bintFromU128(q), prec), nil
 	}
 
 	// exponent > 38 --> 10^exponent = pow10[exponent - 38] * pow10[38]
@@ -1605,7 +1853,7 @@ func (d Decimal) tryInversePowIntU128(e int) (Decimal, error) {
 		return Decimal{}, err
 	}
 
-	return newDecimal(neg, bintFromU128(q), defaultPrec), nil
+	return newDecimal(neg, bintFromU128(q), prec), nil
 }
 
 // Sqrt returns the square root of d using Newton-Raphson method. (https://en.wikipedia.org/wiki/Newton%27s_method)
@@ -1617,10 +1865,20 @@ func (d Decimal) tryInversePowIntU128(e int) (Decimal, error) {
 //	Sqrt(4) = 2
 //	Sqrt(2) = 1.4142135623730950488
 func (d Decimal) Sqrt() (Decimal, error) {
+	return DefaultContext().Sqrt(d)
+}
+
+func (d Decimal) sqrt(prec uint8) (Decimal, error) {
 	if d.neg {
 		return Decimal{}, ErrSqrtNegative
 	}
 
+	// Digits of d beyond 2*prec can't affect the truncated result,
+	// so drop them to make sure the factor below is never negative
+	if d.prec > 2*prec {
+		d = d.Trunc(2 * prec)
+	}
+
 	if d.coef.IsZero() {
 		return Zero, nil
 	}
@@ -1630,7 +1888,7 @@ func (d Decimal) Sqrt() (Decimal, error) {
 	}
 
 	if !d.coef.overflow() {
-		q, err := d.sqrtU128()
+		q, err := d.sqrtU128(prec)
 		if err == nil {
 			return q, nil
 		}
@@ -1638,13 +1896,13 @@ func (d Decimal) Sqrt() (Decimal, error) {
 
 	// overflow, fallback to big.Int
 	dBig := d.coef.GetBig()
-	factor := 2*defaultPrec - d.prec
+	factor := 2*prec - d.prec
 	coef := dBig.Mul(dBig, pow10[factor].ToBigInt())
-	return newDecimal(false, bintFromBigInt(coef.Sqrt(coef)), defaultPrec), nil
+	return newDecimal(false, bintFromBigInt(coef.Sqrt(coef)), prec), nil
 }
 
-func (d Decimal) sqrtU128() (Decimal, error) {
-	factor := 2*defaultPrec - d.prec
+func (d Decimal) sqrtU128(prec uint8) (Decimal, error) {
+	factor := 2*prec - d.prec
 
 	coef := d.coef.u128.MulToU256(pow10[factor])
 	if coef.carry.hi != 0 {
@@ -1671,12 +1929,201 @@ func (d Decimal) sqrtU128() (Decimal, error) {
 		}
 
 		x1 = x1.Rsh(1)
-		if x1.Cmp(x) == 0 {
+
+		// the sequence is decreasing as the initial guess is ≥ √coef,
+		// stop when it isn't anymore, otherwise it may oscillate between ⌊√coef⌋ and ⌊√coef⌋+1
+		if x1.Cmp(x) >= 0 {
 			break
 		}
 
 		x = x1
 	}
 
-	return newDecimal(false, bintFromU128(x), defaultPrec), nil
+	return newDecimal(false, bintFromU128(x), prec), nil
+}
+
+// Cbrt returns the cube root of d using Newton-Raphson method.
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+// Negative numbers are supported, e.g. Cbrt(-8) = -2.
+//
+// Examples:
+//
+//	Cbrt(27) = 3
+//	Cbrt(2) = 1.2599210498948731647
+//	Cbrt(-0.001) = -0.1
+func (d Decimal) Cbrt() (Decimal, error) {
+	return DefaultContext().Cbrt(d)
+}
+
+// NthRoot returns the nth root of d using Newton-Raphson method.
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+// Negative numbers are supported when n is odd.
+//
+// Returns error if:
+//   - n < 1 or n > 1000 ([ErrInvalidRootIndex])
+//   - d < 0 and n is even ([ErrEvenRootNegative])
+//
+// Examples:
+//
+//	NthRoot(32, 5) = 2
+//	NthRoot(2, 4) = 1.1892071150027210667
+//	NthRoot(-32, 5) = -2
+func (d Decimal) NthRoot(n int) (Decimal, error) {
+	return DefaultContext().NthRoot(d, n)
+}
+
+func (d Decimal) nthRoot(n int, prec uint8) (Decimal, error) {
+	if n < 1 || n > maxRootIndex {
+		return Decimal{}, ErrInvalidRootIndex
+	}
+
+	if d.neg && n%2 == 0 {
+		return Decimal{}, ErrEvenRootNegative
+	}
+
+	switch n {
+	case 1:
+		return d.Trunc(prec), nil
+	case 2:
+		return d.sqrt(prec)
+	}
+
+	// Digits of d beyond n*prec can't affect the truncated result,
+	// so drop them to make sure the factor below is never negative
+	if int(d.prec) > n*int(prec) {
+		//nolint:gosec // n*prec < d.prec <= 19, so it's safe to convert to uint8
+		d = d.Trunc(uint8(n * int(prec)))
+	}
+
+	if d.coef.IsZero() {
+		return Zero, nil
+	}
+
+	if !d.coef.overflow() {
+		q, err := d.nthRootU256(n, prec)
+		if err == nil {
+			return q, nil
+		}
+	}
+
+	// overflow, fallback to big.Int
+	coef := d.coef.GetBig()
+	coef.Mul(coef, new(big.Int).Exp(bigTen, big.NewInt(int64(n*int(prec)-int(d.prec))), nil))
+
+	return newDecimal(d.neg, compactBint(nthRootBig(coef, n)), prec), nil
+}
+
+// nthRootU256 calculates the nth root of d with n >= 3 using u256 arithmetic.
+// Returns errOverflow if the intermediate values don't fit.
+func (d Decimal) nthRootU256(n int, prec uint8) (Decimal, error) {
+	// coef = d.coef * 10^factor, at most 2 multiplications with pow10[38] to keep it in u256
+	factor := n*int(prec) - int(d.prec)
+	if factor > 2*38 {
+		return Decimal{}, errOverflow
+	}
+
+	coef := d.coef.u128.MulToU256(pow10[min(factor, 38)])
+	if factor > 38 {
+		var err error
+
+		coef, err = coef.mul128(pow10[factor-38])
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	//nolint:gosec // 0 <= coef.bitLen() < 256, so it's safe to convert to uint
+	bitLen := uint(coef.bitLen())
+
+	// initial guess = 2^ceil(bitLen / n) ≥ ⁿ√coef
+	//nolint:gosec // 3 <= n <= maxRootIndex, so it's safe to convert to uint
+	x := one128.Lsh((bitLen + uint(n) - 1) / uint(n))
+
+	// Newton-Raphson method
+	for {
+		// calculate x1 = ((n-1)*x + coef/x^(n-1)) / n
+		xp, err := u256{hi: x.hi, lo: x.lo}.pow(n - 1)
+		if err != nil || !xp.carry.IsZero() {
+			return Decimal{}, errOverflow
+		}
+
+		y, _, err := coef.fastQuo(u128{hi: xp.hi, lo: xp.lo})
+		if err != nil {
+			return Decimal{}, err
+		}
+
+		//nolint:gosec // 3 <= n <= maxRootIndex, so it's safe to convert to uint64
+		x1, err := x.Mul64(uint64(n - 1))
+		if err != nil {
+			return Decimal{}, err
+		}
+
+		x1, err = x1.Add(y)
+		if err != nil {
+			return Decimal{}, err
+		}
+
+		//nolint:gosec // 3 <= n <= maxRootIndex, so it's safe to convert to uint64
+		x1, _ = x1.QuoRem64(uint64(n))
+
+		// the sequence is decreasing as the initial guess is ≥ ⁿ√coef, stop when it isn't anymore
+		if x1.Cmp(x) >= 0 {
+			break
+		}
+
+		x = x1
+	}
+
+	return newDecimal(d.neg, bintFromU128(x), prec), nil
+}
+
+// nthRootBig returns ⌊ⁿ√coef⌋ with coef > 0 and n >= 3
+func nthRootBig(coef *big.Int, n int) *big.Int {
+	var (
+		nBig  = big.NewInt(int64(n))
+		n1Big = big.NewInt(int64(n - 1))
+		xp    = new(big.Int)
+		y     = new(big.Int)
+	)
+
+	// x1 = ((n-1)*x + coef/x^(n-1)) / n
+	next := func(x *big.Int) *big.Int {
+		xp.Exp(x, n1Big, nil)
+		y.Quo(coef, xp)
+
+		x1 := new(big.Int).Mul(x, n1Big)
+		x1.Add(x1, y)
+
+		return x1.Quo(x1, nBig)
+	}
+
+	// Initial guess from the float64 approximation of log2(coef) / n.
+	// After one step, x ≥ ⌊ⁿ√coef⌋ regardless of the guess (AM-GM inequality)
+	// and the sequence is decreasing from there.
+	shift := max(coef.BitLen()-64, 0)
+	top, _ := new(big.Float).SetInt(new(big.Int).Rsh(coef, uint(shift))).Float64() //nolint:gosec // shift >= 0
+	exp := (math.Log2(top) + float64(shift)) / float64(n)
+
+	intPart := int(exp)
+	x := new(big.Int).SetUint64(uint64(math.Exp2(exp-float64(intPart)) * (1 << 52)))
+	if intPart >= 52 {
+		x.Lsh(x, uint(intPart-52))
+	} else {
+		x.Rsh(x, uint(52-intPart))
+	}
+
+	if x.Sign() == 0 {
+		x.SetInt64(1)
+	}
+
+	x = next(x)
+
+	for {
+		x1 := next(x)
+		if x1.Cmp(x) >= 0 {
+			return x
+		}
+
+		x = x1
+	}
 }
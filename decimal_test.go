@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -39,7 +40,7 @@ func TestPrecOutOfRange(t *testing.T) {
 	SetDefaultPrecision(10)
 
 	_, err := Parse("0.12345678901234569")
-	require.Equal(t, ErrPrecOutOfRange, err)
+	require.ErrorIs(t, err, ErrPrecOutOfRange)
 }
 
 func TestNewFromHiLo(t *testing.T) {
@@ -128,38 +129,113 @@ func TestParse(t *testing.T) {
 		{"340282366920938463463374607431768211459", "340282366920938463463374607431768211459", nil},
 		{"340282366920938463463374607431768211459.123", "340282366920938463463374607431768211459.123", nil},
 		{"+340282366920938463463374607431768211459", "340282366920938463463374607431768211459", nil},
-		{"340282366920938463463374607431768211459.", "", fmt.Errorf("%w: can't parse '340282366920938463463374607431768211459.'", ErrInvalidFormat)},
-		{"--340282366920938463463374607431768211459", "", fmt.Errorf("%w: can't parse '--340282366920938463463374607431768211459'", ErrInvalidFormat)},
-		{".1234567890123456789012345678901234567890123456", "", fmt.Errorf("%w: can't parse '.1234567890123456789012345678901234567890123456'", ErrInvalidFormat)},
-		{"+.1234567890123456789012345678901234567890123456", "", fmt.Errorf("%w: can't parse '+.1234567890123456789012345678901234567890123456'", ErrInvalidFormat)},
-		{"-.1234567890123456789012345678901234567890123456", "", fmt.Errorf("%w: can't parse '-.1234567890123456789012345678901234567890123456'", ErrInvalidFormat)},
-		{"1.12345678903.456", "", fmt.Errorf("%w: can't parse '1.12345678903.456'", ErrInvalidFormat)},
-		{"340282366920938463463374607431768211459.123+--", "", fmt.Errorf("%w: can't parse '340282366920938463463374607431768211459.123+--'", ErrInvalidFormat)},
+		{"340282366920938463463374607431768211459.", "", ErrInvalidFormat},
+		{"--340282366920938463463374607431768211459", "", ErrInvalidFormat},
+		{".1234567890123456789012345678901234567890123456", "", ErrInvalidFormat},
+		{"+.1234567890123456789012345678901234567890123456", "", ErrInvalidFormat},
+		{"-.1234567890123456789012345678901234567890123456", "", ErrInvalidFormat},
+		{"1.12345678903.456", "", ErrInvalidFormat},
+		{"340282366920938463463374607431768211459.123+--", "", ErrInvalidFormat},
 		{"", "", ErrEmptyString},
 		{"1.234567890123456789012348901", "", ErrPrecOutOfRange},
 		{"1.123456789012345678912345678901234567890123456", "", ErrPrecOutOfRange},
-		{".", "", fmt.Errorf("%w: can't parse '.'", ErrInvalidFormat)},
-		{"123.", "", fmt.Errorf("%w: can't parse '123.'", ErrInvalidFormat)},
-		{"-123.", "", fmt.Errorf("%w: can't parse '-123.'", ErrInvalidFormat)},
-		{"-.123456", "", fmt.Errorf("%w: can't parse '-.123456'", ErrInvalidFormat)},
-		{"12c45.123456", "", fmt.Errorf("%w: can't parse '12c45.123456'", ErrInvalidFormat)},
-		{"1245.-123456", "", fmt.Errorf("%w: can't parse '1245.-123456'", ErrInvalidFormat)},
-		{"1245.123.456", "", fmt.Errorf("%w: can't parse '1245.123.456'", ErrInvalidFormat)},
-		{"12345..123456", "", fmt.Errorf("%w: can't parse '12345..123456'", ErrInvalidFormat)},
-		{"123456.123c456", "", fmt.Errorf("%w: can't parse '123456.123c456'", ErrInvalidFormat)},
-		{"+.", "", fmt.Errorf("%w: can't parse '+.'", ErrInvalidFormat)},
-		{"+", "", fmt.Errorf("%w: can't parse '+'", ErrInvalidFormat)},
-		{"-", "", fmt.Errorf("%w: can't parse '-'", ErrInvalidFormat)},
-		{"abc.1234567890123456789", "", fmt.Errorf("%w: can't parse 'abc.1234567890123456789'", ErrInvalidFormat)},
-		{"123.1234567890123456abc", "", fmt.Errorf("%w: can't parse '123.1234567890123456abc'", ErrInvalidFormat)},
-		{"12345678901234567890123456789012345679801234567890.", "", fmt.Errorf("%w: can't parse '12345678901234567890123456789012345679801234567890.'", ErrInvalidFormat)},
+		{".", "", ErrInvalidFormat},
+		{"123.", "", ErrInvalidFormat},
+		{"-123.", "", ErrInvalidFormat},
+		{"-.123456", "", ErrInvalidFormat},
+		{"12c45.123456", "", ErrInvalidFormat},
+		{"1245.-123456", "", ErrInvalidFormat},
+		{"1245.123.456", "", ErrInvalidFormat},
+		{"12345..123456", "", ErrInvalidFormat},
+		{"123456.123c456", "", ErrInvalidFormat},
+		{"+.", "", ErrInvalidFormat},
+		{"+", "", ErrInvalidFormat},
+		{"-", "", ErrInvalidFormat},
+		{"abc.1234567890123456789", "", ErrInvalidFormat},
+		{"123.1234567890123456abc", "", ErrInvalidFormat},
+		{"12345678901234567890123456789012345679801234567890.", "", ErrInvalidFormat},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.input, func(t *testing.T) {
 			d, err := Parse(tc.input)
 			if tc.wantErr != nil {
-				require.Equal(t, tc.wantErr, err)
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, d.String())
+
+			// compare with shopspring/decimal
+			dd, err := decimal.NewFromString(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, dd.String(), d.String())
+		})
+	}
+}
+
+func TestParseScientific(t *testing.T) {
+	testcases := []struct {
+		input, want string
+		wantErr     error
+	}{
+		{"1.23e4", "12300", nil},
+		{"1.23E4", "12300", nil},
+		{"5E-3", "0.005", nil},
+		{"5e-3", "0.005", nil},
+		{"-1.5e+2", "-150", nil},
+		{"+1.5e2", "150", nil},
+		{"1e0", "1", nil},
+		{"-1e-0", "-1", nil},
+		{"123.456e-2", "1.23456", nil},
+		{"123.456e2", "12345.6", nil},
+		{"0012.30e1", "123", nil},
+		{"1e-19", "0.0000000000000000001", nil},
+		{"-1e-19", "-0.0000000000000000001", nil},
+		{"1.0e-19", "0.0000000000000000001", nil},
+		{"1000e-22", "0.0000000000000000001", nil},
+		{"1.2345678901234567890123456789e10", "12345678901.234567890123456789", nil},
+		{"123456789012345678901234567890.123456789e-5", "1234567890123456789012345.67890123456789", nil},
+		{"1e38", "100000000000000000000000000000000000000", nil},
+		{"1e39", "1000000000000000000000000000000000000000", nil},
+		{"3.40282366920938463463374607431768211456e38", "340282366920938463463374607431768211456", nil},
+		{"1e199", "1" + strings.Repeat("0", 199), nil},
+		{"0e10", "0", nil},
+		{"-0e-100", "0", nil},
+		{"0.000e5", "0", nil},
+		{"0e-1000000", "0", nil},
+		{"1e200", "", ErrMaxStrLen},
+		{"1.5e199", "15" + strings.Repeat("0", 198), nil},
+		{"1.5e200", "", ErrMaxStrLen},
+		{"1e1000000", "", ErrMaxStrLen},
+		{"1e-20", "", ErrPrecOutOfRange},
+		{"1.5e-19", "", ErrPrecOutOfRange},
+		{"1e-1000000", "", ErrPrecOutOfRange},
+		{"1.12345678901234567890123e2", "", ErrPrecOutOfRange},
+		{"e", "", ErrInvalidFormat},
+		{"e5", "", ErrInvalidFormat},
+		{"-e5", "", ErrInvalidFormat},
+		{"1e", "", ErrInvalidFormat},
+		{"1e+", "", ErrInvalidFormat},
+		{"1e-", "", ErrInvalidFormat},
+		{".5e3", "", ErrInvalidFormat},
+		{"1.e3", "", ErrInvalidFormat},
+		{"1e5.5", "", ErrInvalidFormat},
+		{"1e5e5", "", ErrInvalidFormat},
+		{"1ee5", "", ErrInvalidFormat},
+		{"1.2.3e4", "", ErrInvalidFormat},
+		{"1e 5", "", ErrInvalidFormat},
+		{"1E+-5", "", ErrInvalidFormat},
+		{"--1e5", "", ErrInvalidFormat},
+		{"0x1p5", "", ErrInvalidFormat},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := Parse(tc.input)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
 				return
 			}
 
@@ -204,23 +280,29 @@ func TestMustParse(t *testing.T) {
 		{"340282366920938463463374607431768211459", nil},
 		{"1.234567890123456789012348901", ErrPrecOutOfRange},
 		{"", ErrEmptyString},
-		{".", fmt.Errorf("%w: can't parse '.'", ErrInvalidFormat)},
-		{"123.", fmt.Errorf("%w: can't parse '123.'", ErrInvalidFormat)},
-		{"-123.", fmt.Errorf("%w: can't parse '-123.'", ErrInvalidFormat)},
-		{"-.123456", fmt.Errorf("%w: can't parse '-.123456'", ErrInvalidFormat)},
-		{"12c45.123456", fmt.Errorf("%w: can't parse '12c45.123456'", ErrInvalidFormat)},
-		{"12345..123456", fmt.Errorf("%w: can't parse '12345..123456'", ErrInvalidFormat)},
-		{"+.", fmt.Errorf("%w: can't parse '+.'", ErrInvalidFormat)},
-		{"+", fmt.Errorf("%w: can't parse '+'", ErrInvalidFormat)},
-		{"-", fmt.Errorf("%w: can't parse '-'", ErrInvalidFormat)},
+		{".", ErrInvalidFormat},
+		{"123.", ErrInvalidFormat},
+		{"-123.", ErrInvalidFormat},
+		{"-.123456", ErrInvalidFormat},
+		{"12c45.123456", ErrInvalidFormat},
+		{"12345..123456", ErrInvalidFormat},
+		{"+.", ErrInvalidFormat},
+		{"+", ErrInvalidFormat},
+		{"-", ErrInvalidFormat},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.s, func(t *testing.T) {
 			if tc.wantErr != nil {
-				require.PanicsWithError(t, tc.wantErr.Error(), func() {
+				err := func() (err error) {
+					defer func() {
+						err, _ = recover().(error)
+					}()
+
 					MustParse(tc.s)
-				})
+					return nil
+				}()
+				require.ErrorIs(t, err, tc.wantErr)
 				return
 			}
 
@@ -1012,7 +1094,7 @@ func TestDivWithCustomPrecision(t *testing.T) {
 		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
 			a, err := Parse(tc.a)
 			if tc.parseErr != nil {
-				require.Equal(t, tc.parseErr, err)
+				require.ErrorIs(t, err, tc.parseErr)
 				return
 			}
 
@@ -1125,6 +1207,170 @@ func TestDiv64(t *testing.T) {
 	}
 }
 
+func TestDivRound(t *testing.T) {
+	testcases := []struct {
+		a, b    string
+		prec    uint8
+		mode    RoundingMode
+		want    string
+		wantErr error
+	}{
+		{"1", "3", 2, RoundDown, "0.33", nil},
+		{"1", "3", 2, RoundUp, "0.34", nil},
+		{"1", "3", 2, RoundCeiling, "0.34", nil},
+		{"1", "3", 2, RoundFloor, "0.33", nil},
+		{"1", "3", 2, RoundHalfUp, "0.33", nil},
+		{"1", "3", 2, RoundHalfDown, "0.33", nil},
+		{"1", "3", 2, RoundHalfEven, "0.33", nil},
+		{"-1", "3", 2, RoundDown, "-0.33", nil},
+		{"-1", "3", 2, RoundUp, "-0.34", nil},
+		{"-1", "3", 2, RoundCeiling, "-0.33", nil},
+		{"-1", "3", 2, RoundFloor, "-0.34", nil},
+		{"-1", "3", 2, RoundHalfUp, "-0.33", nil},
+		{"2", "3", 2, RoundHalfDown, "0.67", nil},
+		{"1", "8", 2, RoundDown, "0.12", nil},
+		{"1", "8", 2, RoundUp, "0.13", nil},
+		{"1", "8", 2, RoundHalfUp, "0.13", nil},
+		{"1", "8", 2, RoundHalfDown, "0.12", nil},
+		{"1", "8", 2, RoundHalfEven, "0.12", nil},
+		{"3", "8", 2, RoundHalfEven, "0.38", nil},
+		{"-5", "8", 2, RoundHalfUp, "-0.63", nil},
+		{"-5", "8", 2, RoundHalfDown, "-0.62", nil},
+		{"-5", "8", 2, RoundHalfEven, "-0.62", nil},
+		{"-5", "8", 2, RoundCeiling, "-0.62", nil},
+		{"-5", "8", 2, RoundFloor, "-0.63", nil},
+		{"1", "4", 2, RoundUp, "0.25", nil},
+		{"1", "4", 2, RoundDown, "0.25", nil},
+		{"0", "4", 2, RoundUp, "0", nil},
+		{"1.2345", "1", 2, RoundDown, "1.23", nil},
+		{"1.2345", "1", 2, RoundUp, "1.24", nil},
+		{"1.2345", "1", 3, RoundHalfEven, "1.234", nil},
+		{"1.2355", "1", 3, RoundHalfEven, "1.236", nil},
+		{"1.005", "1", 2, RoundHalfUp, "1.01", nil},
+		{"1.005", "1", 2, RoundHalfEven, "1", nil},
+		{"0.0000000000000000001", "0.0000000000000000002", 0, RoundHalfUp, "1", nil},
+		{"0.0000000000000000001", "0.0000000000000000002", 0, RoundHalfDown, "0", nil},
+		{"2.5", "1", 0, RoundHalfEven, "2", nil},
+		{"3.5", "1", 0, RoundHalfEven, "4", nil},
+		{"9.99", "1", 1, RoundHalfUp, "10", nil},
+		{"340282366920938463463374607431768211455", "1", 0, RoundUp, "340282366920938463463374607431768211455", nil},
+		{"340282366920938463463374607431768211455", "0.3", 3, RoundDown, "1134274556403128211544582024772560704850", nil},
+		{"340282366920938463463374607431768211455", "10", 0, RoundHalfUp, "34028236692093846346337460743176821146", nil},
+		{"340282366920938463463374607431768211455", "10", 0, RoundHalfDown, "34028236692093846346337460743176821145", nil},
+		{"1234567890123456789012345678901234567890.123", "7", 2, RoundDown, "176366841446208112716049382700176366841.44", nil},
+		{"1234567890123456789012345678901234567890.123", "7", 2, RoundUp, "176366841446208112716049382700176366841.45", nil},
+		{"-1234567890123456789012345678901234567890.125", "0.5", 1, RoundHalfEven, "-2469135780246913578024691357802469135780.2", nil},
+		{"-1234567890123456789012345678901234567890.125", "0.5", 1, RoundHalfUp, "-2469135780246913578024691357802469135780.3", nil},
+		{"1", "0", 2, RoundHalfUp, "", ErrDivideByZero},
+		{"1", "3", 20, RoundHalfUp, "", ErrPrecOutOfRange},
+		{"1", "3", 2, RoundingMode(100), "", ErrInvalidRoundingMode},
+		{"1", "8", 3, RoundUnnecessary, "0.125", nil},
+		{"-1.5", "0.5", 0, RoundUnnecessary, "-3", nil},
+		{"1", "8", 2, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"1", "3", 19, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"1234567890123456789012345678901234567890.125", "0.5", 2, RoundUnnecessary, "2469135780246913578024691357802469135780.25", nil},
+		{"1234567890123456789012345678901234567890.123", "7", 2, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"1", "8", 2, RoundHalfCeiling, "0.13", nil},
+		{"-1", "8", 2, RoundHalfCeiling, "-0.12", nil},
+		{"1", "8", 2, RoundHalfFloor, "0.12", nil},
+		{"-1", "8", 2, RoundHalfFloor, "-0.13", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s %d %d", tc.a, tc.b, tc.prec, tc.mode), func(t *testing.T) {
+			a := MustParse(tc.a)
+			b := MustParse(tc.b)
+
+			c, err := a.DivRound(b, tc.prec, tc.mode)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+
+			// RoundHalfUp can be compared with shopspring/decimal
+			if tc.mode == RoundHalfUp {
+				cc := decimal.RequireFromString(tc.a).DivRound(decimal.RequireFromString(tc.b), int32(tc.prec))
+				require.Equal(t, cc.String(), c.String())
+			}
+		})
+	}
+}
+
+func TestDivRoundSingleRounding(t *testing.T) {
+	// a / b = 0.1250000000000000000001
+	a := MustParse("1250000000000000000001")
+	b := MustParse("10000000000000000000000")
+
+	// Div truncates to 0.125, rounding it again gives the wrong result
+	q, err := a.Div(b)
+	require.NoError(t, err)
+	require.Equal(t, "0.12", q.RoundBank(2).String())
+
+	q, err = a.DivRound(b, 2, RoundHalfEven)
+	require.NoError(t, err)
+	require.Equal(t, "0.13", q.String())
+}
+
+func TestDiv64Round(t *testing.T) {
+	testcases := []struct {
+		a       string
+		b       uint64
+		prec    uint8
+		mode    RoundingMode
+		want    string
+		wantErr error
+	}{
+		{"1", 3, 2, RoundDown, "0.33", nil},
+		{"1", 3, 2, RoundUp, "0.34", nil},
+		{"-1", 3, 2, RoundCeiling, "-0.33", nil},
+		{"-1", 3, 2, RoundFloor, "-0.34", nil},
+		{"1", 8, 2, RoundHalfUp, "0.13", nil},
+		{"1", 8, 2, RoundHalfDown, "0.12", nil},
+		{"1", 8, 2, RoundHalfEven, "0.12", nil},
+		{"3", 8, 2, RoundHalfEven, "0.38", nil},
+		{"100", 3, 0, RoundHalfUp, "33", nil},
+		{"1.2345", 1, 2, RoundUp, "1.24", nil},
+		{"1.2345", 1, 3, RoundHalfEven, "1.234", nil},
+		{"1", math.MaxUint64, 19, RoundUp, "0.0000000000000000001", nil},
+		{"1", math.MaxUint64, 19, RoundHalfUp, "0.0000000000000000001", nil},
+		{"9223372036854775808", math.MaxUint64, 0, RoundHalfUp, "1", nil},
+		{"9223372036854775807", math.MaxUint64, 0, RoundHalfUp, "0", nil},
+		{"340282366920938463463374607431768211455", 10, 0, RoundHalfUp, "34028236692093846346337460743176821146", nil},
+		{"1234567890123456789012345678901234567890.123", 7, 2, RoundUp, "176366841446208112716049382700176366841.45", nil},
+		{"1", 0, 2, RoundHalfUp, "", ErrDivideByZero},
+		{"1", 3, 20, RoundHalfUp, "", ErrPrecOutOfRange},
+		{"1", 3, 2, RoundingMode(100), "", ErrInvalidRoundingMode},
+		{"1", 8, 3, RoundUnnecessary, "0.125", nil},
+		{"1", 8, 2, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"1.2345", 1, 2, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"-1", 8, 2, RoundHalfCeiling, "-0.12", nil},
+		{"-1", 8, 2, RoundHalfFloor, "-0.13", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%d %d %d", tc.a, tc.b, tc.prec, tc.mode), func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			c, err := a.Div64Round(tc.b, tc.prec, tc.mode)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+
+			// must be the same as DivRound
+			c2, err := a.DivRound(MustFromUint64(tc.b, 0), tc.prec, tc.mode)
+			require.NoError(t, err)
+			require.Equal(t, c2, c)
+		})
+	}
+}
+
 func TestQuoRem(t *testing.T) {
 	testcases := []struct {
 		a, b    string
@@ -1143,6 +1389,7 @@ func TestQuoRem(t *testing.T) {
 		{"11.234", "-1.12", MustParse("-10"), MustParse("0.034"), nil},
 		{"-11.234", "-1.12", MustParse("10"), MustParse("-0.034"), nil},
 		{"123.456", "1.123", MustParse("109"), MustParse("1.049"), nil},
+		{"1199038364791120855235", "65.0000000000000000078", MustParse("18446744073709551616"), MustParse("51.1153962250654973952"), nil},
 		{"-11.234", "0", MustParse("10"), MustParse("-0.034"), ErrDivideByZero},
 	}
 
@@ -1222,6 +1469,36 @@ func TestMod(t *testing.T) {
 	}
 }
 
+func TestIsMultipleOf(t *testing.T) {
+	testcases := []struct {
+		a, step string
+		want    bool
+	}{
+		{"1.25", "0.05", true},
+		{"1.23", "0.05", false},
+		{"-1.25", "0.05", true},
+		{"1.25", "-0.05", true},
+		{"0.003", "0.001", true},
+		{"0.0035", "0.001", false},
+		{"100", "0.25", true},
+		{"100", "3", false},
+		{"0", "0.05", true},
+		{"0", "0", true},
+		{"1", "0", false},
+		{"0.05", "1.25", false},
+		{"246913578024691357802469135780246913578", "123456789012345678901234567890123456789", true},
+		{"246913578024691357802469135780246913579", "123456789012345678901234567890123456789", false},
+		{"123456789012345678901234567890123456789.15", "0.05", true},
+		{"123456789012345678901234567890123456789.16", "0.05", false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s.isMultipleOf(%s)", tc.a, tc.step), func(t *testing.T) {
+			require.Equal(t, tc.want, MustParse(tc.a).IsMultipleOf(MustParse(tc.step)))
+		})
+	}
+}
+
 func TestCmp(t *testing.T) {
 	testcases := []struct {
 		a, b string
@@ -2069,6 +2346,193 @@ func TestCeil(t *testing.T) {
 	}
 }
 
+func TestFloorPrec(t *testing.T) {
+	testcases := []struct {
+		a        string
+		prec     uint8
+		want     string
+		overflow bool
+	}{
+		{"123456789012345678901234567890123456789.9999999999999999999", 3, "123456789012345678901234567890123456789.999", true},
+		{"-123456789012345678901234567890123456789.9999999999999999999", 3, "-123456789012345678901234567890123456790", true},
+		{"-123456789012345678901234567890123456789.1230000000000000001", 3, "-123456789012345678901234567890123456789.124", true},
+		{"-340282366920938463463374607431768211.455", 2, "-340282366920938463463374607431768211.46", false},
+		{"9999999999999999999.9999999999999999999", 0, "9999999999999999999", false},
+		{"-9999999999999999999.9999999999999999999", 18, "-10000000000000000000", false},
+		{"123.456000", 0, "123", false},
+		{"123.456000", 1, "123.4", false},
+		{"123.456000", 2, "123.45", false},
+		{"123.456000", 3, "123.456", false},
+		{"123.456000", 7, "123.456", false},
+		{"-123.456000", 0, "-124", false},
+		{"-123.456000", 1, "-123.5", false},
+		{"-123.456000", 2, "-123.46", false},
+		{"-123.456000", 3, "-123.456", false},
+		{"-123.456000", 7, "-123.456", false},
+		{"123.1234567890987654321", 18, "123.123456789098765432", false},
+		{"-123.1234567890987654321", 18, "-123.123456789098765433", false},
+		{"-0.0000000000000000001", 0, "-1", false},
+		{"0.0000000000000000001", 0, "0", false},
+		{"0", 2, "0", false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s.floorPrec(%d)", tc.a, tc.prec), func(t *testing.T) {
+			a, err := Parse(tc.a)
+			require.NoError(t, err)
+
+			aStr := a.String()
+
+			b := a.FloorPrec(tc.prec)
+			assertOverflow(t, a, tc.overflow)
+
+			require.Equal(t, tc.want, b.String())
+
+			// make sure a is immutable
+			require.Equal(t, aStr, a.String())
+
+			// cross check with shopspring/decimal
+			aa := decimal.RequireFromString(tc.a)
+			aa = aa.RoundFloor(int32(tc.prec))
+
+			require.Equal(t, aa.String(), b.String())
+		})
+	}
+}
+
+func TestCeilPrec(t *testing.T) {
+	testcases := []struct {
+		a        string
+		prec     uint8
+		want     string
+		overflow bool
+	}{
+		{"123456789012345678901234567890123456789.9999999999999999999", 3, "123456789012345678901234567890123456790", true},
+		{"123456789012345678901234567890123456789.1230000000000000001", 3, "123456789012345678901234567890123456789.124", true},
+		{"-123456789012345678901234567890123456789.9999999999999999999", 3, "-123456789012345678901234567890123456789.999", true},
+		{"340282366920938463463374607431768211.455", 2, "340282366920938463463374607431768211.46", false},
+		{"9999999999999999999.9999999999999999999", 18, "10000000000000000000", false},
+		{"-9999999999999999999.9999999999999999999", 0, "-9999999999999999999", false},
+		{"123.456000", 0, "124", false},
+		{"123.456000", 1, "123.5", false},
+		{"123.456000", 2, "123.46", false},
+		{"123.456000", 3, "123.456", false},
+		{"123.456000", 7, "123.456", false},
+		{"-123.456000", 0, "-123", false},
+		{"-123.456000", 1, "-123.4", false},
+		{"-123.456000", 2, "-123.45", false},
+		{"-123.456000", 3, "-123.456", false},
+		{"-123.456000", 7, "-123.456", false},
+		{"123.1234567890987654321", 18, "123.123456789098765433", false},
+		{"-123.1234567890987654321", 18, "-123.123456789098765432", false},
+		{"0.0000000000000000001", 0, "1", false},
+		{"-0.0000000000000000001", 0, "0", false},
+		{"0", 2, "0", false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s.ceilPrec(%d)", tc.a, tc.prec), func(t *testing.T) {
+			a, err := Parse(tc.a)
+			require.NoError(t, err)
+
+			aStr := a.String()
+
+			b := a.CeilPrec(tc.prec)
+			assertOverflow(t, a, tc.overflow)
+
+			require.Equal(t, tc.want, b.String())
+
+			// make sure a is immutable
+			require.Equal(t, aStr, a.String())
+
+			// cross check with shopspring/decimal
+			aa := decimal.RequireFromString(tc.a)
+			aa = aa.RoundCeil(int32(tc.prec))
+
+			require.Equal(t, aa.String(), b.String())
+		})
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	testcases := []struct {
+		a, step string
+		mode    RoundingMode
+		want    string
+		wantErr error
+	}{
+		{"1.23", "0.05", RoundDown, "1.2", nil},
+		{"1.23", "0.05", RoundUp, "1.25", nil},
+		{"1.23", "0.05", RoundHalfUp, "1.25", nil},
+		{"1.23", "0.05", RoundFloor, "1.2", nil},
+		{"1.23", "0.05", RoundCeiling, "1.25", nil},
+		{"1.225", "0.05", RoundHalfUp, "1.25", nil},
+		{"1.225", "0.05", RoundHalfDown, "1.2", nil},
+		{"1.225", "0.05", RoundHalfEven, "1.2", nil},
+		{"1.225", "0.05", RoundHalfCeiling, "1.25", nil},
+		{"1.225", "0.05", RoundHalfFloor, "1.2", nil},
+		{"1.275", "0.05", RoundHalfEven, "1.3", nil},
+		{"-1.225", "0.05", RoundHalfUp, "-1.25", nil},
+		{"-1.225", "0.05", RoundHalfDown, "-1.2", nil},
+		{"-1.225", "0.05", RoundHalfEven, "-1.2", nil},
+		{"-1.225", "0.05", RoundHalfCeiling, "-1.2", nil},
+		{"-1.225", "0.05", RoundHalfFloor, "-1.25", nil},
+		{"-1.23", "0.25", RoundDown, "-1", nil},
+		{"-1.23", "0.25", RoundUp, "-1.25", nil},
+		{"-1.23", "0.25", RoundFloor, "-1.25", nil},
+		{"-1.23", "0.25", RoundCeiling, "-1", nil},
+		{"-1.23", "0.25", RoundHalfUp, "-1.25", nil},
+		{"-1.23", "-0.25", RoundFloor, "-1.25", nil},
+		{"1.23", "-0.25", RoundCeiling, "1.25", nil},
+		{"0.12345", "0.001", RoundDown, "0.123", nil},
+		{"0.12345", "0.001", RoundUp, "0.124", nil},
+		{"0.12345", "0.001", RoundHalfEven, "0.123", nil},
+		{"0.02", "0.05", RoundDown, "0", nil},
+		{"0.02", "0.05", RoundUp, "0.05", nil},
+		{"0.02", "0.05", RoundHalfUp, "0", nil},
+		{"-0.02", "0.05", RoundDown, "0", nil},
+		{"-0.02", "0.05", RoundFloor, "-0.05", nil},
+		{"-0.02", "0.05", RoundCeiling, "0", nil},
+		{"17", "5", RoundHalfUp, "15", nil},
+		{"17", "5", RoundDown, "15", nil},
+		{"12.5", "5", RoundHalfEven, "10", nil},
+		{"12.5", "5", RoundHalfUp, "15", nil},
+		{"1.25", "0.05", RoundUp, "1.25", nil},
+		{"1.25", "0.05", RoundDown, "1.25", nil},
+		{"0", "0.05", RoundUp, "0", nil},
+		{"123456789012345678901234567890123456789.123", "0.05", RoundHalfUp, "123456789012345678901234567890123456789.1", nil},
+		{"123456789012345678901234567890123456789.123", "0.05", RoundDown, "123456789012345678901234567890123456789.1", nil},
+		{"123456789012345678901234567890123456789.123", "0.05", RoundUp, "123456789012345678901234567890123456789.15", nil},
+		{"-123456789012345678901234567890123456789.125", "0.25", RoundHalfEven, "-123456789012345678901234567890123456789", nil},
+		{"-123456789012345678901234567890123456789.125", "0.25", RoundFloor, "-123456789012345678901234567890123456789.25", nil},
+		{"1.123", "123456789012345678901234567890123456789", RoundDown, "0", nil},
+		{"1.123", "123456789012345678901234567890123456789", RoundUp, "123456789012345678901234567890123456789", nil},
+		{"3402823669209384634633746074317682114.55", "0.1", RoundHalfUp, "3402823669209384634633746074317682114.6", nil},
+		{"3402823669209384634633746074317682114.55", "0.1", RoundDown, "3402823669209384634633746074317682114.5", nil},
+		{"1.25", "0.05", RoundUnnecessary, "1.25", nil},
+		{"1.23", "0.05", RoundUnnecessary, "", ErrRoundingNecessary},
+		{"1.23", "0", RoundHalfUp, "", ErrDivideByZero},
+		{"1.23", "0.05", RoundingMode(100), "", ErrInvalidRoundingMode},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s.roundToIncrement(%s, %s)", tc.a, tc.step, tc.mode), func(t *testing.T) {
+			a := MustParse(tc.a)
+			step := MustParse(tc.step)
+
+			b, err := a.RoundToIncrement(step, tc.mode)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+			require.True(t, b.IsMultipleOf(step))
+		})
+	}
+}
+
 func TestTrunc(t *testing.T) {
 	testcases := []struct {
 		a    string
@@ -2184,6 +2648,234 @@ func TestTrunc(t *testing.T) {
 	}
 }
 
+func TestRound(t *testing.T) {
+	testcases := []struct {
+		a       string
+		prec    uint8
+		mode    RoundingMode
+		want    string
+		wantErr error
+	}{
+		{"1.125", 2, RoundDown, "1.12", nil},
+		{"1.125", 2, RoundUp, "1.13", nil},
+		{"1.125", 2, RoundCeiling, "1.13", nil},
+		{"1.125", 2, RoundFloor, "1.12", nil},
+		{"1.125", 2, RoundHalfUp, "1.13", nil},
+		{"1.125", 2, RoundHalfDown, "1.12", nil},
+		{"1.125", 2, RoundHalfEven, "1.12", nil},
+		{"1.125", 2, RoundHalfCeiling, "1.13", nil},
+		{"1.125", 2, RoundHalfFloor, "1.12", nil},
+		{"-1.125", 2, RoundDown, "-1.12", nil},
+		{"-1.125", 2, RoundUp, "-1.13", nil},
+		{"-1.125", 2, RoundCeiling, "-1.12", nil},
+		{"-1.125", 2, RoundFloor, "-1.13", nil},
+		{"-1.125", 2, RoundHalfUp, "-1.13", nil},
+		{"-1.125", 2, RoundHalfDown, "-1.12", nil},
+		{"-1.125", 2, RoundHalfEven, "-1.12", nil},
+		{"-1.125", 2, RoundHalfCeiling, "-1.12", nil},
+		{"-1.125", 2, RoundHalfFloor, "-1.13", nil},
+		{"1.135", 2, RoundDown, "1.13", nil},
+		{"1.135", 2, RoundUp, "1.14", nil},
+		{"1.135", 2, RoundCeiling, "1.14", nil},
+		{"1.135", 2, RoundFloor, "1.13", nil},
+		{"1.135", 2, RoundHalfUp, "1.14", nil},
+		{"1.135", 2, RoundHalfDown, "1.13", nil},
+		{"1.135", 2, RoundHalfEven, "1.14", nil},
+		{"1.135", 2, RoundHalfCeiling, "1.14", nil},
+		{"1.135", 2, RoundHalfFloor, "1.13", nil},
+		{"-1.135", 2, RoundDown, "-1.13", nil},
+		{"-1.135", 2, RoundUp, "-1.14", nil},
+		{"-1.135", 2, RoundCeiling, "-1.13", nil},
+		{"-1.135", 2, RoundFloor, "-1.14", nil},
+		{"-1.135", 2, RoundHalfUp, "-1.14", nil},
+		{"-1.135", 2, RoundHalfDown, "-1.13", nil},
+		{"-1.135", 2, RoundHalfEven, "-1.14", nil},
+		{"-1.135", 2, RoundHalfCeiling, "-1.13", nil},
+		{"-1.135", 2, RoundHalfFloor, "-1.14", nil},
+		{"1.121", 2, RoundDown, "1.12", nil},
+		{"1.121", 2, RoundUp, "1.13", nil},
+		{"1.121", 2, RoundCeiling, "1.13", nil},
+		{"1.121", 2, RoundFloor, "1.12", nil},
+		{"1.121", 2, RoundHalfUp, "1.12", nil},
+		{"1.121", 2, RoundHalfDown, "1.12", nil},
+		{"1.121", 2, RoundHalfEven, "1.12", nil},
+		{"1.121", 2, RoundHalfCeiling, "1.12", nil},
+		{"1.121", 2, RoundHalfFloor, "1.12", nil},
+		{"-1.121", 2, RoundDown, "-1.12", nil},
+		{"-1.121", 2, RoundUp, "-1.13", nil},
+		{"-1.121", 2, RoundCeiling, "-1.12", nil},
+		{"-1.121", 2, RoundFloor, "-1.13", nil},
+		{"-1.121", 2, RoundHalfUp, "-1.12", nil},
+		{"-1.121", 2, RoundHalfDown, "-1.12", nil},
+		{"-1.121", 2, RoundHalfEven, "-1.12", nil},
+		{"-1.121", 2, RoundHalfCeiling, "-1.12", nil},
+		{"-1.121", 2, RoundHalfFloor, "-1.12", nil},
+		{"1.129", 2, RoundDown, "1.12", nil},
+		{"1.129", 2, RoundUp, "1.13", nil},
+		{"1.129", 2, RoundCeiling, "1.13", nil},
+		{"1.129", 2, RoundFloor, "1.12", nil},
+		{"1.129", 2, RoundHalfUp, "1.13", nil},
+		{"1.129", 2, RoundHalfDown, "1.13", nil},
+		{"1.129", 2, RoundHalfEven, "1.13", nil},
+		{"1.129", 2, RoundHalfCeiling, "1.13", nil},
+		{"1.129", 2, RoundHalfFloor, "1.13", nil},
+		{"-1.129", 2, RoundDown, "-1.12", nil},
+		{"-1.129", 2, RoundUp, "-1.13", nil},
+		{"-1.129", 2, RoundCeiling, "-1.12", nil},
+		{"-1.129", 2, RoundFloor, "-1.13", nil},
+		{"-1.129", 2, RoundHalfUp, "-1.13", nil},
+		{"-1.129", 2, RoundHalfDown, "-1.13", nil},
+		{"-1.129", 2, RoundHalfEven, "-1.13", nil},
+		{"-1.129", 2, RoundHalfCeiling, "-1.13", nil},
+		{"-1.129", 2, RoundHalfFloor, "-1.13", nil},
+		{"0.5", 0, RoundDown, "0", nil},
+		{"0.5", 0, RoundUp, "1", nil},
+		{"0.5", 0, RoundCeiling, "1", nil},
+		{"0.5", 0, RoundFloor, "0", nil},
+		{"0.5", 0, RoundHalfUp, "1", nil},
+		{"0.5", 0, RoundHalfDown, "0", nil},
+		{"0.5", 0, RoundHalfEven, "0", nil},
+		{"0.5", 0, RoundHalfCeiling, "1", nil},
+		{"0.5", 0, RoundHalfFloor, "0", nil},
+		{"-0.5", 0, RoundDown, "0", nil},
+		{"-0.5", 0, RoundUp, "-1", nil},
+		{"-0.5", 0, RoundCeiling, "0", nil},
+		{"-0.5", 0, RoundFloor, "-1", nil},
+		{"-0.5", 0, RoundHalfUp, "-1", nil},
+		{"-0.5", 0, RoundHalfDown, "0", nil},
+		{"-0.5", 0, RoundHalfEven, "0", nil},
+		{"-0.5", 0, RoundHalfCeiling, "0", nil},
+		{"-0.5", 0, RoundHalfFloor, "-1", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundDown, "123456789012345678901234567890123456789.12", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundUp, "123456789012345678901234567890123456789.13", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundCeiling, "123456789012345678901234567890123456789.13", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundFloor, "123456789012345678901234567890123456789.12", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundHalfUp, "123456789012345678901234567890123456789.13", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundHalfDown, "123456789012345678901234567890123456789.12", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundHalfEven, "123456789012345678901234567890123456789.12", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundHalfCeiling, "123456789012345678901234567890123456789.13", nil},
+		{"123456789012345678901234567890123456789.125", 2, RoundHalfFloor, "123456789012345678901234567890123456789.12", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundDown, "-123456789012345678901234567890123456789.12", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundUp, "-123456789012345678901234567890123456789.13", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundCeiling, "-123456789012345678901234567890123456789.12", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundFloor, "-123456789012345678901234567890123456789.13", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundHalfUp, "-123456789012345678901234567890123456789.13", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundHalfDown, "-123456789012345678901234567890123456789.12", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundHalfEven, "-123456789012345678901234567890123456789.12", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundHalfCeiling, "-123456789012345678901234567890123456789.12", nil},
+		{"-123456789012345678901234567890123456789.125", 2, RoundHalfFloor, "-123456789012345678901234567890123456789.13", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundDown, "340282366920938463463374607431768211.45", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundUp, "340282366920938463463374607431768211.46", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundCeiling, "340282366920938463463374607431768211.46", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundFloor, "340282366920938463463374607431768211.45", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundHalfUp, "340282366920938463463374607431768211.46", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundHalfDown, "340282366920938463463374607431768211.45", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundHalfEven, "340282366920938463463374607431768211.46", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundHalfCeiling, "340282366920938463463374607431768211.46", nil},
+		{"340282366920938463463374607431768211.455", 2, RoundHalfFloor, "340282366920938463463374607431768211.45", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundDown, "9999999999999999999", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundUp, "10000000000000000000", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundCeiling, "10000000000000000000", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundFloor, "9999999999999999999", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundHalfUp, "10000000000000000000", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundHalfDown, "10000000000000000000", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundHalfEven, "10000000000000000000", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundHalfCeiling, "10000000000000000000", nil},
+		{"9999999999999999999.9999999999999999999", 0, RoundHalfFloor, "10000000000000000000", nil},
+		{"1.125", 3, RoundUnnecessary, "1.125", nil},
+		{"1.125", 5, RoundUnnecessary, "1.125", nil},
+		{"1.12500", 3, RoundUnnecessary, "1.125", nil},
+		{"-1.100", 1, RoundUnnecessary, "-1.1", nil},
+		{"123456789012345678901234567890123456789.100", 1, RoundUnnecessary, "123456789012345678901234567890123456789.1", nil},
+		{"1.125", 2, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"-1.0000000000000000001", 18, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"123456789012345678901234567890123456789.125", 2, RoundUnnecessary, "", ErrRoundingNecessary},
+		{"1.125", 2, RoundingMode(100), "", ErrInvalidRoundingMode},
+		{"1.125", 5, RoundingMode(100), "", ErrInvalidRoundingMode},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s.round(%d, %s)", tc.a, tc.prec, tc.mode), func(t *testing.T) {
+			a, err := Parse(tc.a)
+			require.NoError(t, err)
+
+			aStr := a.String()
+
+			b, err := a.Round(tc.prec, tc.mode)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+
+			// make sure a is immutable
+			require.Equal(t, aStr, a.String())
+		})
+	}
+}
+
+func TestRoundEquivalence(t *testing.T) {
+	inputs := []string{
+		"0", "1.5", "-1.5", "2.5", "-2.5", "1.12345", "-1.12335", "123.456",
+		"9999999999999999999.9999999999999999999",
+		"-123456789012345678901234567890123456789.9999999999999999999",
+	}
+
+	for _, s := range inputs {
+		a := MustParse(s)
+
+		for prec := uint8(0); prec <= maxPrec; prec++ {
+			for _, tc := range []struct {
+				mode RoundingMode
+				want Decimal
+			}{
+				{RoundDown, a.Trunc(prec)},
+				{RoundUp, a.RoundAwayFromZero(prec)},
+				{RoundHalfUp, a.RoundHAZ(prec)},
+				{RoundHalfDown, a.RoundHTZ(prec)},
+				{RoundHalfEven, a.RoundBank(prec)},
+			} {
+				b, err := a.Round(prec, tc.mode)
+				require.NoError(t, err)
+				require.Equal(t, tc.want, b, "%s.round(%d, %s)", s, prec, tc.mode)
+			}
+		}
+
+		b, err := a.Round(0, RoundFloor)
+		require.NoError(t, err)
+		require.Equal(t, a.Floor(), b)
+
+		b, err = a.Round(0, RoundCeiling)
+		require.NoError(t, err)
+		require.Equal(t, a.Ceil(), b)
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	testcases := []struct {
+		mode RoundingMode
+		want string
+	}{
+		{RoundDown, "RoundDown"},
+		{RoundUp, "RoundUp"},
+		{RoundCeiling, "RoundCeiling"},
+		{RoundFloor, "RoundFloor"},
+		{RoundHalfUp, "RoundHalfUp"},
+		{RoundHalfDown, "RoundHalfDown"},
+		{RoundHalfEven, "RoundHalfEven"},
+		{RoundHalfCeiling, "RoundHalfCeiling"},
+		{RoundHalfFloor, "RoundHalfFloor"},
+		{RoundUnnecessary, "RoundUnnecessary"},
+		{RoundingMode(100), "RoundingMode(100)"},
+	}
+
+	for _, tc := range testcases {
+		require.Equal(t, tc.want, tc.mode.String())
+	}
+}
+
 func TestTrimTrailingZeros(t *testing.T) {
 	testcases := []struct {
 		neg           bool
@@ -2720,6 +3412,102 @@ func TestSqrt(t *testing.T) {
 	}
 }
 
+func TestCbrt(t *testing.T) {
+	testcases := []struct {
+		a       string
+		want    string
+		wantErr error
+	}{
+		{"0", "0", nil},
+		{"1", "1", nil},
+		{"-1", "-1", nil},
+		{"8", "2", nil},
+		{"27", "3", nil},
+		{"-27", "-3", nil},
+		{"0.001", "0.1", nil},
+		{"-0.001", "-0.1", nil},
+		{"2", "1.2599210498948731647", nil},
+		{"-2", "-1.2599210498948731647", nil},
+		{"0.5", "0.7937005259840997373", nil},
+		{"1000000000000", "10000", nil},
+		{"0.0000000000000000001", "0.0000004641588833612", nil},
+		{"12345678901234567890.1234567890123456789", "2311204.2409018362511439609", nil},
+		{"12345678901234567890123456789012345678901234567890", "23112042409018362.5114396092108297847", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("cbrt(%s)", tc.a), func(t *testing.T) {
+			a, err := Parse(tc.a)
+			require.NoError(t, err)
+
+			aStr := a.String()
+
+			b, err := a.Cbrt()
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+
+			// make sure a is immutable
+			require.Equal(t, aStr, a.String())
+
+			// Cbrt is the same as NthRoot(3)
+			c, err := a.NthRoot(3)
+			require.NoError(t, err)
+			require.Equal(t, b, c)
+		})
+	}
+}
+
+func TestNthRoot(t *testing.T) {
+	testcases := []struct {
+		a       string
+		n       int
+		want    string
+		wantErr error
+	}{
+		{"2", 1, "2", nil},
+		{"-1.23", 1, "-1.23", nil},
+		{"2", 2, "1.4142135623730950488", nil},
+		{"32", 5, "2", nil},
+		{"-32", 5, "-2", nil},
+		{"64", 6, "2", nil},
+		{"2", 4, "1.1892071150027210667", nil},
+		{"0.0001", 4, "0.1", nil},
+		{"0", 7, "0", nil},
+		{"1", 100, "1", nil},
+		{"-1", 101, "-1", nil},
+		{"123456789012345678901234567890123456789", 7, "276468.0801734619304488123", nil},
+		{"2", 1000, "1.0006933874625806325", nil},
+		{"0.5", 1000, "0.9993070929904525219", nil},
+		{"-2", 999, "-1.000694081784943754", nil},
+		{"2", 0, "", ErrInvalidRootIndex},
+		{"2", -3, "", ErrInvalidRootIndex},
+		{"2", 1001, "", ErrInvalidRootIndex},
+		{"-2", 2, "", ErrEvenRootNegative},
+		{"-16", 4, "", ErrEvenRootNegative},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("nthroot(%s, %d)", tc.a, tc.n), func(t *testing.T) {
+			a, err := Parse(tc.a)
+			require.NoError(t, err)
+
+			b, err := a.NthRoot(tc.n)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+		})
+	}
+}
+
 func TestRandomSqrt(t *testing.T) {
 	// from 0.1 to 100
 	for i := 1; i <= 1000; i++ {
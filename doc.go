@@ -10,6 +10,9 @@
 // can be changed globally to any value between 1 and 19 to suit your use case and make sure
 // that the precision is consistent across the entire application. See [SetDefaultPrecision] for more details.
 //
+// If different parts of the application need different settings, use a [Context] instead.
+// A Context carries its own precision and parse mode and never touches the package-level defaults.
+//
 // # Codec
 //
 // The udecimal package supports various encoding and decoding mechanisms to facilitate easy integration with
@@ -1,6 +1,7 @@
 package udecimal
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -16,6 +17,72 @@ func ExampleSetDefaultPrecision() {
 	// 0.2981998909
 }
 
+func ExampleContext() {
+	ctx := Context{Prec: 4, ParseMode: ParseModeTrunc}
+
+	a, _ := ctx.Parse("1.234567")
+	fmt.Println(a)
+
+	b, _ := ctx.Div(a, MustParse("3"))
+	fmt.Println(b)
+
+	// package-level functions use the default context
+	c, _ := a.Div(MustParse("3"))
+	fmt.Println(c)
+	// Output:
+	// 1.2345
+	// 0.4115
+	// 0.4115
+}
+
+func ExampleContext_Sqrt() {
+	ctx := Context{Prec: 6}
+
+	fmt.Println(ctx.Sqrt(MustParse("2")))
+	// Output:
+	// 1.414213 <nil>
+}
+
+func ExampleContext_Mul() {
+	a := MustParse("18446744073709551616")
+
+	ctx := Context{Prec: 2}
+	fmt.Println(ctx.Mul(MustParse("1.23"), MustParse("1.23")))
+	fmt.Println(ctx.Mul(a, a))
+
+	ctx.Overflow = OverflowModeError
+	fmt.Println(ctx.Mul(a, a))
+	// Output:
+	// 1.51 <nil>
+	// 340282366920938463463374607431768211456 <nil>
+	// 0 overflow. The coefficient of the result doesn't fit in 128 bits: 18446744073709551616 * 18446744073709551616
+}
+
+func ExampleContext_MulSat() {
+	// limit the notional to 1,000,000
+	ctx := Context{Prec: 2, MaxMagnitude: MustParse("1000000")}
+
+	fmt.Println(ctx.MulSat(MustParse("12.5"), MustParse("1000")))
+	fmt.Println(ctx.MulSat(MustParse("-12.5"), MustParse("100000")))
+	// Output:
+	// 12500 false
+	// -1000000 true
+}
+
+func ExampleAccumulator() {
+	prices := []Decimal{MustParse("101.25"), MustParse("99.5"), MustParse("100.125")}
+	quantities := []Decimal{MustParse("10"), MustParse("-4"), MustParse("2.5")}
+
+	acc := NewAccumulator(2)
+	for i := range prices {
+		acc.AddProduct(prices[i], quantities[i])
+	}
+
+	fmt.Println(acc.Result())
+	// Output:
+	// 864.81
+}
+
 func ExampleMustFromFloat64() {
 	fmt.Println(MustFromFloat64(1.234))
 
@@ -85,6 +152,10 @@ func ExampleParse() {
 	fmt.Println(Parse("-1234567890123456789.1234567890123456789"))
 	fmt.Println(Parse("-0.00007890123456789"))
 
+	// scientific notation
+	fmt.Println(Parse("1.23e4"))
+	fmt.Println(Parse("-5E-3"))
+
 	// error cases
 	fmt.Println(Parse("0.12345678901234567890123"))
 	fmt.Println(Parse(""))
@@ -94,9 +165,25 @@ func ExampleParse() {
 	// 1234567890123456789.1234567890123456789 <nil>
 	// -1234567890123456789.1234567890123456789 <nil>
 	// -0.00007890123456789 <nil>
-	// 0 precision out of range. Only support maximum 19 digits after the decimal point
+	// 12300 <nil>
+	// -0.005 <nil>
+	// 0 precision out of range. Only support maximum 19 digits after the decimal point: can't parse '0.12345678901234567890123' at offset 21: too many digits after the decimal point
 	// 0 can't parse empty string
-	// 0 invalid format: can't parse '1.123.123'
+	// 0 invalid format: can't parse '1.123.123' at offset 5: unexpected character '.'
+}
+
+func ExampleParseError() {
+	_, err := Parse("12c45.6")
+
+	var perr *ParseError
+	if errors.As(err, &perr) {
+		fmt.Println(perr.Offset, perr.Reason)
+	}
+
+	fmt.Println(errors.Is(err, ErrInvalidFormat))
+	// Output:
+	// 2 unexpected character
+	// true
 }
 
 func ExampleNewFromHiLo() {
@@ -122,6 +209,38 @@ func ExampleDecimal_Add() {
 	// 5.35475
 }
 
+func ExampleDecimal_AddChecked() {
+	a := MustParse("340282366920938463463374607431768211455")
+
+	fmt.Println(a.AddChecked(MustParse("-1")))
+
+	_, err := a.AddChecked(One)
+	fmt.Println(errors.Is(err, ErrOverflow))
+	// Output:
+	// 340282366920938463463374607431768211454 <nil>
+	// true
+}
+
+func ExampleDecimal_AddSat() {
+	a := MustParse("340282366920938463463374607431768211455")
+
+	fmt.Println(a.AddSat(MustParse("-1")))
+	fmt.Println(a.AddSat(One))
+	// Output:
+	// 340282366920938463463374607431768211454 false
+	// 340282366920938463463374607431768211455 true
+}
+
+func ExampleDecimal_Clamp() {
+	lo, hi := MustParse("-100"), MustParse("100")
+
+	fmt.Println(MustParse("12.5").Clamp(lo, hi))
+	fmt.Println(MustParse("-150").Clamp(lo, hi))
+	// Output:
+	// 12.5 false
+	// -100 true
+}
+
 func ExampleDecimal_Add64() {
 	a := MustParse("1.23")
 	c := a.Add64(4)
@@ -222,6 +341,26 @@ func ExampleDecimal_Div64() {
 	// 0 can't divide by zero
 }
 
+func ExampleDecimal_DivRound() {
+	fmt.Println(MustParse("2").DivRound(MustParse("3"), 2, RoundDown))
+	fmt.Println(MustParse("2").DivRound(MustParse("3"), 2, RoundHalfUp))
+	fmt.Println(MustParse("1").DivRound(MustParse("8"), 2, RoundHalfEven))
+	fmt.Println(MustParse("1").DivRound(MustParse("0"), 2, RoundHalfEven))
+	// Output:
+	// 0.66 <nil>
+	// 0.67 <nil>
+	// 0.12 <nil>
+	// 0 can't divide by zero
+}
+
+func ExampleDecimal_Div64Round() {
+	fmt.Println(MustParse("1.23").Div64Round(4, 3, RoundDown))
+	fmt.Println(MustParse("1.23").Div64Round(4, 3, RoundHalfEven))
+	// Output:
+	// 0.307 <nil>
+	// 0.308 <nil>
+}
+
 func ExampleDecimal_QuoRem() {
 	fmt.Println(MustParse("1.23").QuoRem(MustParse("0.5")))
 	fmt.Println(MustParse("1.23").QuoRem(MustParse("0")))
@@ -280,6 +419,22 @@ func ExampleDecimal_Floor() {
 	// -2
 }
 
+func ExampleDecimal_FloorPrec() {
+	fmt.Println(MustParse("1.129").FloorPrec(2))
+	fmt.Println(MustParse("-1.121").FloorPrec(2))
+	// Output:
+	// 1.12
+	// -1.13
+}
+
+func ExampleDecimal_CeilPrec() {
+	fmt.Println(MustParse("1.121").CeilPrec(2))
+	fmt.Println(MustParse("-1.129").CeilPrec(2))
+	// Output:
+	// 1.13
+	// -1.12
+}
+
 func ExampleDecimal_Int64() {
 	fmt.Println(MustParse("1.23").Int64())
 	fmt.Println(MustParse("1234567890123456789.1234567890123456789").Int64())
@@ -469,6 +624,18 @@ func ExampleDecimal_PowInt32() {
 	// 0 can't raise zero to a negative power
 }
 
+func ExampleDecimal_Pow() {
+	fmt.Println(MustParse("2").Pow(MustParse("0.5")))
+	fmt.Println(MustParse("4").Pow(MustParse("-1.5")))
+	fmt.Println(MustParse("1.05").Pow(MustParse("2.5")))
+	fmt.Println(MustParse("-8").Pow(MustParse("0.5")))
+	// Output:
+	// 1.4142135623730950488 <nil>
+	// 0.125 <nil>
+	// 1.1297263219470457217 <nil>
+	// 0 can't raise negative number to a fractional power
+}
+
 func ExampleDecimal_Prec() {
 	fmt.Println(MustParse("1.23").Prec())
 	// Output:
@@ -481,6 +648,22 @@ func ExampleDecimal_PrecUint() {
 	// 5
 }
 
+func ExampleDecimal_Round() {
+	fmt.Println(MustParse("1.125").Round(2, RoundHalfEven))
+	fmt.Println(MustParse("1.125").Round(2, RoundHalfUp))
+	fmt.Println(MustParse("-1.125").Round(2, RoundHalfCeiling))
+	fmt.Println(MustParse("-1.121").Round(2, RoundFloor))
+	fmt.Println(MustParse("1.120").Round(2, RoundUnnecessary))
+	fmt.Println(MustParse("1.125").Round(2, RoundUnnecessary))
+	// Output:
+	// 1.12 <nil>
+	// 1.13 <nil>
+	// -1.12 <nil>
+	// -1.13 <nil>
+	// 1.12 <nil>
+	// 0 rounding necessary. The result can't be represented exactly with the requested precision
+}
+
 func ExampleDecimal_RoundBank() {
 	fmt.Println(MustParse("1.12345").RoundBank(4))
 	fmt.Println(MustParse("1.12335").RoundBank(4))
@@ -557,6 +740,68 @@ func ExampleDecimal_Sqrt() {
 	// 0 can't calculate square root of negative number
 }
 
+func ExampleDecimal_Cbrt() {
+	fmt.Println(MustParse("27").Cbrt())
+	fmt.Println(MustParse("-0.125").Cbrt())
+	fmt.Println(MustParse("2").Cbrt())
+	// Output:
+	// 3 <nil>
+	// -0.5 <nil>
+	// 1.2599210498948731647 <nil>
+}
+
+func ExampleDecimal_NthRoot() {
+	fmt.Println(MustParse("32").NthRoot(5))
+	fmt.Println(MustParse("-32").NthRoot(5))
+	fmt.Println(MustParse("2").NthRoot(4))
+	fmt.Println(MustParse("-16").NthRoot(4))
+	// Output:
+	// 2 <nil>
+	// -2 <nil>
+	// 1.1892071150027210667 <nil>
+	// 0 can't calculate even root of negative number
+}
+
+func ExampleDecimal_Exp() {
+	fmt.Println(MustParse("1").Exp())
+	fmt.Println(MustParse("-1").Exp())
+	fmt.Println(MustParse("500").Exp())
+	// Output:
+	// 2.7182818284590452353 <nil>
+	// 0.3678794411714423215 <nil>
+	// 0 exp overflow. The result must be less than or equal to e^414
+}
+
+func ExampleDecimal_Ln() {
+	fmt.Println(MustParse("2").Ln())
+	fmt.Println(MustParse("0.5").Ln())
+	fmt.Println(MustParse("0").Ln())
+	// Output:
+	// 0.6931471805599453094 <nil>
+	// -0.6931471805599453094 <nil>
+	// 0 can't calculate logarithm of non-positive number
+}
+
+func ExampleDecimal_Log10() {
+	fmt.Println(MustParse("1000").Log10())
+	fmt.Println(MustParse("0.01").Log10())
+	fmt.Println(MustParse("2").Log10())
+	// Output:
+	// 3 <nil>
+	// -2 <nil>
+	// 0.3010299956639811952 <nil>
+}
+
+func ExampleDecimal_Log2() {
+	fmt.Println(MustParse("8").Log2())
+	fmt.Println(MustParse("0.25").Log2())
+	fmt.Println(MustParse("10").Log2())
+	// Output:
+	// 3 <nil>
+	// -2 <nil>
+	// 3.3219280948873623478 <nil>
+}
+
 func ExampleDecimal_String() {
 	fmt.Println(MustParse("1.23").String())
 	fmt.Println(MustParse("-1.230000").String())
@@ -565,6 +810,26 @@ func ExampleDecimal_String() {
 	// -1.23
 }
 
+func ExampleDecimal_Format() {
+	d := MustParse("1234.5678")
+
+	fmt.Printf("%v\n", d)
+	fmt.Printf("%.2f\n", d)
+	fmt.Printf("%10.1f|\n", d)
+	fmt.Printf("%-10.1f|\n", d)
+	fmt.Printf("%+010.2f\n", d)
+	fmt.Printf("%.3e\n", d)
+	fmt.Printf("%q\n", d)
+	// Output:
+	// 1234.5678
+	// 1234.57
+	//     1234.6|
+	// 1234.6    |
+	// +001234.57
+	// 1.235e+03
+	// "1234.5678"
+}
+
 func ExampleDecimal_StringFixed() {
 	fmt.Println(MustParse("1").StringFixed(2))
 	fmt.Println(MustParse("1.23").StringFixed(4))
@@ -575,6 +840,68 @@ func ExampleDecimal_StringFixed() {
 	// -1.23000
 }
 
+func ExampleDecimal_StringSci() {
+	fmt.Println(MustParse("1234567.89").StringSci(0))
+	fmt.Println(MustParse("1234567.89").StringSci(3))
+	fmt.Println(MustParse("-0.00012").StringSci(0))
+	// Output:
+	// 1.23456789e+6
+	// 1.23e+6
+	// -1.2e-4
+}
+
+func ExampleDecimal_StringEng() {
+	fmt.Println(MustParse("1234567.89").StringEng(0))
+	fmt.Println(MustParse("12345").StringEng(0))
+	fmt.Println(MustParse("-0.00012").StringEng(0))
+	fmt.Println(MustParse("123456").StringEng(1))
+	// Output:
+	// 1.23456789e+6
+	// 12.345e+3
+	// -120e-6
+	// 100e+3
+}
+
+func ExampleDecimal_RoundToIncrement() {
+	fmt.Println(MustParse("1.23").RoundToIncrement(MustParse("0.05"), RoundHalfUp))
+	fmt.Println(MustParse("-1.23").RoundToIncrement(MustParse("0.25"), RoundFloor))
+	fmt.Println(MustParse("0.12345").RoundToIncrement(MustParse("0.001"), RoundDown))
+	fmt.Println(MustParse("1.23").RoundToIncrement(MustParse("0"), RoundDown))
+	// Output:
+	// 1.25 <nil>
+	// -1.25 <nil>
+	// 0.123 <nil>
+	// 0 can't divide by zero
+}
+
+func ExampleDecimal_IsMultipleOf() {
+	fmt.Println(MustParse("1.25").IsMultipleOf(MustParse("0.05")))
+	fmt.Println(MustParse("1.23").IsMultipleOf(MustParse("0.05")))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleDecimal_Allocate() {
+	fmt.Println(MustParse("100").Allocate(2, MustParse("1"), MustParse("1"), MustParse("1")))
+	fmt.Println(MustParse("0.05").Allocate(2, MustParse("0.3"), MustParse("0.7")))
+	fmt.Println(MustParse("-10").Allocate(0, MustParse("1"), MustParse("2")))
+	// Output:
+	// [33.34 33.33 33.33] <nil>
+	// [0.02 0.03] <nil>
+	// [-3 -7] <nil>
+}
+
+func ExampleDecimal_Split() {
+	fmt.Println(MustParse("100").Split(3, 2))
+	fmt.Println(MustParse("-0.05").Split(3, 2))
+	fmt.Println(MustParse("100").Split(0, 2))
+	// Output:
+	// [33.34 33.33 33.33] <nil>
+	// [-0.02 -0.02 -0.01] <nil>
+	// [] invalid ratios. Must be non-negative and sum up to a positive number
+}
+
 func ExampleDecimal_Trunc() {
 	fmt.Println(MustParse("1.23").Trunc(1))
 	fmt.Println(MustParse("-1.23").Trunc(5))
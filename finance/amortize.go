@@ -0,0 +1,170 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+var (
+	// ErrInvalidMethod is returned when the amortization method is not one of the defined methods
+	ErrInvalidMethod = fmt.Errorf("invalid amortization method")
+
+	// ErrInvalidSchedule is returned when, once rounded, the principal part of a payment has the opposite sign
+	// of the remaining balance or is larger than it, e.g. when opts.Prec is too low for the loan amount
+	ErrInvalidSchedule = fmt.Errorf("invalid schedule. The rounded principal part of a payment must be between 0 and the remaining balance")
+)
+
+// AmortizationMethod is how the principal of a loan is repaid over the periods.
+type AmortizationMethod uint8
+
+const (
+	// Annuity (French amortization) repays the loan with constant payments.
+	// The interest part decreases and the principal part increases over time.
+	Annuity AmortizationMethod = iota
+
+	// EqualPrincipal repays the same amount of principal each period, plus the interest on the balance,
+	// so the payments decrease over time.
+	EqualPrincipal
+
+	// InterestOnly pays only the interest each period, and the whole principal with the last payment.
+	InterestOnly
+)
+
+// AmortizeOptions holds the settings of [Amortize].
+// The zero value is an annuity with monthly payments rounded down to whole units.
+type AmortizeOptions struct {
+	// Method is how the principal is repaid, the zero value is [Annuity]
+	Method AmortizationMethod
+
+	// PeriodsPerYear is the number of payments per year, e.g. 12 for monthly payments.
+	// The rate of each period is annualRate / PeriodsPerYear. The zero value means 12.
+	PeriodsPerYear int32
+
+	// Prec is the number of digits after the decimal point of the amounts of the schedule,
+	// usually the minor units of the currency, e.g. 2 for USD
+	Prec uint8
+
+	// Rounding is the rounding mode of the payment of an annuity and of the interest of each period
+	Rounding udecimal.RoundingMode
+}
+
+// Installment is a row of an amortization schedule.
+// Payment = Interest + Principal, and Balance is the principal remaining after the payment.
+type Installment struct {
+	Period    int32
+	Payment   udecimal.Decimal
+	Interest  udecimal.Decimal
+	Principal udecimal.Decimal
+	Balance   udecimal.Decimal
+}
+
+// Amortize returns the amortization schedule of a loan of principal repaid in periods payments,
+// with the interest rate annualRate (e.g. 0.06 for 6% per year) and the payments at the end of each period.
+//
+// The interest of each period is the balance times annualRate / opts.PeriodsPerYear,
+// rounded to opts.Prec digits using opts.Rounding. The principal parts of the payments are rounded too,
+// so the last installment repays the remaining balance, which absorbs the rounding differences of the previous ones:
+// the principal parts always sum up exactly to principal and the last balance is 0.
+//
+// Returns error if:
+//   - periods <= 0 or opts.PeriodsPerYear < 0: [ErrInvalidPeriod]
+//   - opts.Method is not valid: [ErrInvalidMethod]
+//   - principal has more than opts.Prec digits after the decimal point: [udecimal.ErrRoundingNecessary]
+//   - opts.Rounding is not valid: [udecimal.ErrInvalidRoundingMode]
+//   - the principal part of a payment, before the last one, has the opposite sign of the balance
+//     or is larger than it, e.g. 3 at 300% in 6 monthly payments of 1: [ErrInvalidSchedule]
+//
+// Example:
+//
+//	Amortize(1000, 0.12, 3, {Prec: 2, Rounding: RoundHalfUp}) =
+//
+//	Period  Payment  Interest  Principal  Balance
+//	     1   340.02     10.00     330.02   669.98
+//	     2   340.02      6.70     333.32   336.66
+//	     3   340.03      3.37     336.66     0.00
+func Amortize(principal, annualRate udecimal.Decimal, periods int32, opts AmortizeOptions) ([]Installment, error) {
+	if periods <= 0 || opts.PeriodsPerYear < 0 {
+		return nil, ErrInvalidPeriod
+	}
+
+	if opts.Method > InterestOnly {
+		return nil, ErrInvalidMethod
+	}
+
+	if !principal.Trunc(opts.Prec).Equal(principal) {
+		return nil, udecimal.ErrRoundingNecessary
+	}
+
+	perYear := opts.PeriodsPerYear
+	if perYear == 0 {
+		perYear = 12
+	}
+
+	ctx := udecimal.Context{Prec: opts.Prec, Rounding: opts.Rounding}
+	perYearDec := udecimal.MustFromInt64(int64(perYear), 0)
+
+	// the principal part of each payment, except the last one, for EqualPrincipal and InterestOnly,
+	// and the payment for Annuity
+	var (
+		fixed udecimal.Decimal
+		err   error
+	)
+
+	switch opts.Method {
+	case Annuity:
+		// never fails because perYear > 0
+		rate, _ := exact.Div(annualRate, perYearDec)
+
+		fixed, err = PMT(ctx, rate, periods, principal.Neg(), udecimal.Zero, EndOfPeriod)
+	case EqualPrincipal:
+		//nolint:gosec // periods > 0, so it's safe to convert to uint64
+		fixed, err = ctx.Div64(principal, uint64(periods))
+	case InterestOnly:
+		fixed = udecimal.Zero
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make([]Installment, periods)
+	balance := principal
+
+	for i := range schedule {
+		// interest = balance * annualRate / perYear, rounded once
+		interest, err := ctx.Div(exact.Mul(balance, annualRate), perYearDec)
+		if err != nil {
+			return nil, err
+		}
+
+		var repaid udecimal.Decimal
+		switch {
+		case i == len(schedule)-1:
+			repaid = balance
+		case opts.Method == Annuity:
+			repaid = fixed.Sub(interest)
+		default:
+			repaid = fixed
+		}
+
+		// the rounded payments must neither increase the balance nor repay more than it
+		if repaid.Sign()*balance.Sign() < 0 || repaid.Abs().GreaterThan(balance.Abs()) {
+			return nil, ErrInvalidSchedule
+		}
+
+		balance = balance.Sub(repaid)
+
+		schedule[i] = Installment{
+			//nolint:gosec // i < periods, so it's safe to convert to int32
+			Period:    int32(i + 1),
+			Payment:   interest.Add(repaid),
+			Interest:  interest,
+			Principal: repaid,
+			Balance:   balance,
+		}
+	}
+
+	return schedule, nil
+}
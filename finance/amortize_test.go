@@ -0,0 +1,249 @@
+package finance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+// row formats an installment as "period payment interest principal balance"
+func row(in Installment, prec uint8) string {
+	return fmt.Sprintf("%d %s %s %s %s", in.Period,
+		in.Payment.StringFixed(prec), in.Interest.StringFixed(prec), in.Principal.StringFixed(prec), in.Balance.StringFixed(prec))
+}
+
+func TestAmortize(t *testing.T) {
+	testcases := []struct {
+		name      string
+		principal string
+		rate      string
+		periods   int32
+		opts      AmortizeOptions
+		want      []string
+	}{
+		{
+			name:      "annuity",
+			principal: "1000",
+			rate:      "0.12",
+			periods:   3,
+			opts:      AmortizeOptions{Prec: 2, Rounding: udecimal.RoundHalfUp},
+			want: []string{
+				"1 340.02 10.00 330.02 669.98",
+				"2 340.02 6.70 333.32 336.66",
+				"3 340.03 3.37 336.66 0.00",
+			},
+		},
+		{
+			name:      "annuity rounded down",
+			principal: "1000",
+			rate:      "0.12",
+			periods:   3,
+			opts:      AmortizeOptions{Prec: 2},
+			want: []string{
+				"1 340.02 10.00 330.02 669.98",
+				"2 340.02 6.69 333.33 336.65",
+				"3 340.01 3.36 336.65 0.00",
+			},
+		},
+		{
+			name:      "annuity zero rate",
+			principal: "100",
+			rate:      "0",
+			periods:   3,
+			opts:      AmortizeOptions{Prec: 2, Rounding: udecimal.RoundHalfEven},
+			want: []string{
+				"1 33.33 0.00 33.33 66.67",
+				"2 33.33 0.00 33.33 33.34",
+				"3 33.34 0.00 33.34 0.00",
+			},
+		},
+		{
+			name:      "annuity quarterly",
+			principal: "10000",
+			rate:      "0.08",
+			periods:   4,
+			opts:      AmortizeOptions{PeriodsPerYear: 4, Prec: 2, Rounding: udecimal.RoundHalfUp},
+			want: []string{
+				"1 2626.24 200.00 2426.24 7573.76",
+				"2 2626.24 151.48 2474.76 5099.00",
+				"3 2626.24 101.98 2524.26 2574.74",
+				"4 2626.23 51.49 2574.74 0.00",
+			},
+		},
+		{
+			name:      "equal principal",
+			principal: "1000",
+			rate:      "0.12",
+			periods:   3,
+			opts:      AmortizeOptions{Method: EqualPrincipal, Prec: 2, Rounding: udecimal.RoundHalfUp},
+			want: []string{
+				"1 343.33 10.00 333.33 666.67",
+				"2 340.00 6.67 333.33 333.34",
+				"3 336.67 3.33 333.34 0.00",
+			},
+		},
+		{
+			name:      "equal principal yearly, whole units",
+			principal: "1000",
+			rate:      "0.05",
+			periods:   3,
+			opts:      AmortizeOptions{Method: EqualPrincipal, PeriodsPerYear: 1, Rounding: udecimal.RoundHalfUp},
+			want: []string{
+				"1 383 50 333 667",
+				"2 366 33 333 334",
+				"3 351 17 334 0",
+			},
+		},
+		{
+			name:      "interest only",
+			principal: "1000",
+			rate:      "0.12",
+			periods:   3,
+			opts:      AmortizeOptions{Method: InterestOnly, Prec: 2, Rounding: udecimal.RoundHalfUp},
+			want: []string{
+				"1 10.00 10.00 0.00 1000.00",
+				"2 10.00 10.00 0.00 1000.00",
+				"3 1010.00 10.00 1000.00 0.00",
+			},
+		},
+		{
+			name:      "single period",
+			principal: "1000",
+			rate:      "0.06",
+			periods:   1,
+			opts:      AmortizeOptions{Prec: 2, Rounding: udecimal.RoundHalfUp},
+			want: []string{
+				"1 1005.00 5.00 1000.00 0.00",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := Amortize(udecimal.MustParse(tc.principal), udecimal.MustParse(tc.rate), tc.periods, tc.opts)
+			require.NoError(t, err)
+
+			got := make([]string, len(schedule))
+			for i, in := range schedule {
+				got[i] = row(in, tc.opts.Prec)
+			}
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAmortizeReconciliation(t *testing.T) {
+	testcases := []struct {
+		principal string
+		rate      string
+		periods   int32
+		opts      AmortizeOptions
+		first     string
+		last      string
+		interest  string
+	}{
+		{
+			principal: "200000",
+			rate:      "0.06",
+			periods:   360,
+			opts:      AmortizeOptions{Prec: 2, Rounding: udecimal.RoundHalfUp},
+			first:     "1 1199.10 1000.00 199.10 199800.90",
+			last:      "360 1200.14 5.97 1194.17 0.00",
+			interest:  "231677.04",
+		},
+		{
+			principal: "100000",
+			rate:      "0.05",
+			periods:   120,
+			opts:      AmortizeOptions{Method: EqualPrincipal, Prec: 2, Rounding: udecimal.RoundHalfUp},
+			first:     "1 1250.00 416.67 833.33 99166.67",
+			last:      "120 837.20 3.47 833.73 0.00",
+			interest:  "25208.42",
+		},
+		{
+			principal: "250000",
+			rate:      "0.045",
+			periods:   20,
+			opts:      AmortizeOptions{PeriodsPerYear: 4, Prec: 2, Rounding: udecimal.RoundHalfUp},
+			last:      "20 14028.79 156.07 13872.72 0.00",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s/%d/%+v", tc.principal, tc.rate, tc.periods, tc.opts), func(t *testing.T) {
+			principal := udecimal.MustParse(tc.principal)
+
+			schedule, err := Amortize(principal, udecimal.MustParse(tc.rate), tc.periods, tc.opts)
+			require.NoError(t, err)
+			require.Len(t, schedule, int(tc.periods))
+
+			if tc.first != "" {
+				require.Equal(t, tc.first, row(schedule[0], tc.opts.Prec))
+			}
+
+			require.Equal(t, tc.last, row(schedule[len(schedule)-1], tc.opts.Prec))
+
+			repaid, interest := udecimal.Zero, udecimal.Zero
+			balance := principal
+			for _, in := range schedule {
+				require.Equal(t, in.Payment, in.Interest.Add(in.Principal))
+				require.Equal(t, balance.Sub(in.Principal), in.Balance)
+				require.LessOrEqual(t, in.Interest.Prec(), int(tc.opts.Prec))
+				require.LessOrEqual(t, in.Principal.Prec(), int(tc.opts.Prec))
+
+				balance = in.Balance
+				repaid = repaid.Add(in.Principal)
+				interest = interest.Add(in.Interest)
+			}
+
+			// the principal parts sum up exactly to the loan amount
+			require.True(t, repaid.Equal(principal), repaid.String())
+			require.True(t, balance.IsZero())
+
+			if tc.interest != "" {
+				require.Equal(t, tc.interest, interest.StringFixed(tc.opts.Prec))
+			}
+		})
+	}
+}
+
+func TestAmortizeError(t *testing.T) {
+	principal, rate := udecimal.MustParse("1000"), udecimal.MustParse("0.05")
+
+	_, err := Amortize(principal, rate, 0, AmortizeOptions{Prec: 2})
+	require.ErrorIs(t, err, ErrInvalidPeriod)
+
+	_, err = Amortize(principal, rate, 12, AmortizeOptions{Prec: 2, PeriodsPerYear: -1})
+	require.ErrorIs(t, err, ErrInvalidPeriod)
+
+	_, err = Amortize(principal, rate, 12, AmortizeOptions{Prec: 2, Method: InterestOnly + 1})
+	require.ErrorIs(t, err, ErrInvalidMethod)
+
+	// the principal has more digits than the schedule
+	_, err = Amortize(udecimal.MustParse("1000.005"), rate, 12, AmortizeOptions{Prec: 2})
+	require.ErrorIs(t, err, udecimal.ErrRoundingNecessary)
+
+	_, err = Amortize(udecimal.MustParse("1000.5"), rate, 12, AmortizeOptions{})
+	require.ErrorIs(t, err, udecimal.ErrRoundingNecessary)
+
+	// 3 at 300% in 6 periods: the payment rounded down to 1 keeps repaying 1 once the interest
+	// rounds to 0, which would take the balance below 0 before the last period
+	_, err = Amortize(udecimal.MustParse("3"), udecimal.MustParse("3"), 6, AmortizeOptions{})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	// 1 / 3 rounded up is 1, so nothing is left to repay after the 1st period
+	_, err = Amortize(udecimal.One, rate, 3, AmortizeOptions{Method: EqualPrincipal, Rounding: udecimal.RoundUp})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	// same with a negative principal
+	_, err = Amortize(udecimal.MustParse("-1"), rate, 3, AmortizeOptions{Method: EqualPrincipal, Rounding: udecimal.RoundUp})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	for _, method := range []AmortizationMethod{Annuity, EqualPrincipal, InterestOnly} {
+		_, err = Amortize(principal, rate, 12, AmortizeOptions{Method: method, Prec: 2, Rounding: 100})
+		require.ErrorIs(t, err, udecimal.ErrInvalidRoundingMode)
+	}
+}
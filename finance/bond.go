@@ -0,0 +1,402 @@
+package finance
+
+import (
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/daycount"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+var hundred = udecimal.MustFromInt64(100, 0)
+
+// Frequency is the number of coupon payments per year of a bond.
+// It's the "frequency" argument of the spreadsheet bond functions.
+type Frequency uint8
+
+const (
+	// Annual means 1 coupon payment per year
+	Annual Frequency = 1
+
+	// SemiAnnual means 2 coupon payments per year, e.g. US Treasury notes and bonds
+	SemiAnnual Frequency = 2
+
+	// Quarterly means 4 coupon payments per year
+	Quarterly Frequency = 4
+)
+
+func (f Frequency) valid() bool {
+	return f == Annual || f == SemiAnnual || f == Quarterly
+}
+
+// BondPrice returns the clean price per 100 of face value of a bond paying periodic coupons and redeemed at 100,
+// which yields yield per year, like PRICE(settlement, maturity, rate, yld, 100, frequency, basis) in Excel.
+// coupon and yield are annual rates, e.g. 0.0575 for 5.75%.
+//
+// The clean price doesn't include the interest accrued since the last coupon date, see [BondDirtyPrice].
+// Like in Excel, when there's only 1 coupon left until maturity, the price is discounted with simple interest
+// instead of compounding.
+//
+// The coupon dates are computed backward from maturity, every 12 / frequency months.
+// If maturity is the last day of a month, all the coupon dates are the last day of their month.
+//
+// basis is the day count convention of the bond, the basis argument of Excel maps to:
+//   - 0 (US 30/360): [daycount.Thirty360US]
+//   - 1 (actual/actual): [daycount.ActActISDA], the number of days of a coupon period is the actual one
+//   - 2 (actual/360): [daycount.Act360]
+//   - 3 (actual/365): [daycount.Act365Fixed]
+//   - 4 (European 30/360): [daycount.Thirty360European]
+//
+// Returns error if:
+//   - maturity is not after settlement: [ErrInvalidMaturity]
+//   - frequency is not valid: [ErrInvalidFrequency]
+//   - basis is not valid: [daycount.ErrInvalidConvention]
+//   - coupon is negative or 1 + yield / frequency is not positive: [ErrInvalidRate]
+//
+// Example:
+//
+//	BondPrice({Prec: 8, Rounding: RoundHalfUp}, 2008-02-15, 2017-11-15, 0.0575, 0.065, SemiAnnual, Thirty360US) = 94.63436162
+func BondPrice(
+	ctx udecimal.Context,
+	settlement, maturity time.Time,
+	coupon, yield udecimal.Decimal,
+	frequency Frequency,
+	basis daycount.Convention,
+) (udecimal.Decimal, error) {
+	b, err := newBond(settlement, maturity, coupon, frequency, basis)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	dirty, _, err := b.dirtyPrice(yield)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, dirty.Sub(b.accrued()))
+}
+
+// BondDirtyPrice returns the dirty (full) price per 100 of face value of a bond,
+// i.e. the clean price returned by [BondPrice] plus the interest accrued since the last coupon date,
+// which is 100 * coupon / frequency * A / E, where A is the number of days from the last coupon date
+// to settlement and E the number of days of the coupon period.
+//
+// It takes the same arguments and returns the same errors as [BondPrice].
+//
+// Example:
+//
+//	BondDirtyPrice({Prec: 8, Rounding: RoundHalfUp}, 2008-02-15, 2017-11-15, 0.0575, 0.065, SemiAnnual, Thirty360US) = 96.07186162
+func BondDirtyPrice(
+	ctx udecimal.Context,
+	settlement, maturity time.Time,
+	coupon, yield udecimal.Decimal,
+	frequency Frequency,
+	basis daycount.Convention,
+) (udecimal.Decimal, error) {
+	b, err := newBond(settlement, maturity, coupon, frequency, basis)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	dirty, _, err := b.dirtyPrice(yield)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, dirty)
+}
+
+// BondYield returns the annual yield of a bond from its clean price per 100 of face value,
+// like YIELD(settlement, maturity, rate, pr, 100, frequency, basis) in Excel.
+// It's equivalent to Solver{}.BondYield, see [Solver.BondYield].
+//
+// Example:
+//
+//	BondYield({Prec: 9, Rounding: RoundHalfUp}, 2008-02-15, 2016-11-15, 0.0575, 95.04287, SemiAnnual, Thirty360US) = 0.065000007
+func BondYield(
+	ctx udecimal.Context,
+	settlement, maturity time.Time,
+	coupon, price udecimal.Decimal,
+	frequency Frequency,
+	basis daycount.Convention,
+) (udecimal.Decimal, error) {
+	return Solver{}.BondYield(ctx, settlement, maturity, coupon, price, frequency, basis)
+}
+
+// BondYield returns the annual yield of a bond from its clean price per 100 of face value,
+// like YIELD(settlement, maturity, rate, pr, 100, frequency, basis) in Excel.
+// The arguments are the same as [BondPrice], with the clean price instead of the yield.
+//
+// Like in Excel, when there's only 1 coupon left until maturity, the yield is computed directly
+// with simple interest. Otherwise it's the yield for which [BondPrice] returns price, found iteratively
+// starting from the coupon rate. If no yield is found, a [*ConvergenceError] is returned.
+//
+// Returns error if:
+//   - maturity is not after settlement: [ErrInvalidMaturity]
+//   - frequency is not valid: [ErrInvalidFrequency]
+//   - basis is not valid: [daycount.ErrInvalidConvention]
+//   - coupon is negative: [ErrInvalidRate]
+//   - price is not positive: [ErrInvalidPrice]
+func (s Solver) BondYield(
+	ctx udecimal.Context,
+	settlement, maturity time.Time,
+	coupon, price udecimal.Decimal,
+	frequency Frequency,
+	basis daycount.Convention,
+) (udecimal.Decimal, error) {
+	if !price.IsPos() {
+		return udecimal.Decimal{}, ErrInvalidPrice
+	}
+
+	b, err := newBond(settlement, maturity, coupon, frequency, basis)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	if b.n == 1 {
+		yield, err := b.lastPeriodYield(price)
+		if err != nil {
+			return udecimal.Decimal{}, err
+		}
+
+		return round(ctx, yield)
+	}
+
+	// the equation is dirty price(yield) - (price + accrued interest)
+	target := price.Add(b.accrued())
+
+	yield, err := s.solve(coupon, func(yield udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+		dirty, dp, err := b.dirtyPrice(yield)
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		return dirty.Sub(target), dp, nil
+	})
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, yield)
+}
+
+// bond holds the coupon schedule of a bond at settlement, with the names of the Excel documentation
+type bond struct {
+	// n is the number of coupons payable between settlement and maturity
+	n int32
+
+	// coupon is the payment of each coupon per 100 of face value: 100 * rate / frequency
+	coupon udecimal.Decimal
+
+	// frequency is the number of coupons per year
+	frequency udecimal.Decimal
+
+	// e is the number of days in the coupon period of settlement
+	e udecimal.Decimal
+
+	// a is the number of days from the beginning of the coupon period to settlement
+	a udecimal.Decimal
+
+	// dsc is the number of days from settlement to the next coupon date
+	dsc udecimal.Decimal
+}
+
+func newBond(settlement, maturity time.Time, rate udecimal.Decimal, frequency Frequency, basis daycount.Convention) (bond, error) {
+	if !frequency.valid() {
+		return bond{}, ErrInvalidFrequency
+	}
+
+	if daycount.Days(settlement, maturity) <= 0 {
+		return bond{}, ErrInvalidMaturity
+	}
+
+	if rate.IsNeg() {
+		return bond{}, ErrInvalidRate
+	}
+
+	// the previous coupon date is the k-th coupon date before maturity, the next one is the (k-1)-th
+	k := 1
+	for daycount.Days(settlement, couponDate(maturity, k, frequency)) > 0 {
+		k++
+	}
+
+	pcd, ncd := couponDate(maturity, k, frequency), couponDate(maturity, k-1, frequency)
+
+	days, err := basis.DayCount(pcd, settlement)
+	if err != nil {
+		return bond{}, err
+	}
+
+	freq := udecimal.MustFromInt64(int64(frequency), 0)
+	a := udecimal.MustFromInt64(days, 0)
+
+	var e, dsc udecimal.Decimal
+
+	switch basis {
+	case daycount.ActActISDA:
+		e = udecimal.MustFromInt64(daycount.Days(pcd, ncd), 0)
+	case daycount.Act365Fixed:
+		// never fails because frequency is not zero
+		e, _ = exact.Div(udecimal.MustFromInt64(365, 0), freq)
+	default:
+		e, _ = exact.Div(udecimal.MustFromInt64(360, 0), freq)
+	}
+
+	switch basis {
+	case daycount.Thirty360US, daycount.Thirty360European:
+		dsc = e.Sub(a)
+	default:
+		dsc = udecimal.MustFromInt64(daycount.Days(settlement, ncd), 0)
+	}
+
+	coupon, _ := exact.Div(exact.Mul(hundred, rate), freq)
+
+	return bond{
+		//nolint:gosec // k is the number of coupon periods until maturity, far below math.MaxInt32
+		n:         int32(k),
+		coupon:    coupon,
+		frequency: freq,
+		e:         e,
+		a:         a,
+		dsc:       dsc,
+	}, nil
+}
+
+// accrued returns the interest accrued from the beginning of the coupon period to settlement: coupon * A / E
+func (b bond) accrued() udecimal.Decimal {
+	// never fails because e is positive
+	accrued, _ := exact.Div(exact.Mul(b.coupon, b.a), b.e)
+	return accrued
+}
+
+// dirtyPrice returns the present value of the remaining cash flows at yield and its derivative with respect to yield:
+//
+//	sum(coupon / (1 + yield / frequency)^(k - 1 + DSC / E), k = 1..N) + 100 / (1 + yield / frequency)^(N - 1 + DSC / E)
+//
+// or with simple interest when N = 1, see [bond.lastPeriodPrice].
+func (b bond) dirtyPrice(yield udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+	perPeriod, err := exact.Div(yield, b.frequency)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	base := udecimal.One.Add(perPeriod)
+	if !base.IsPos() {
+		return udecimal.Decimal{}, udecimal.Decimal{}, ErrInvalidRate
+	}
+
+	t, err := exact.Div(b.dsc, b.e)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	if b.n == 1 {
+		return b.lastPeriodPrice(perPeriod, t)
+	}
+
+	f, err := exact.Pow(base, t)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	// discount is 1 / base^(k - 1 + t) and exponent is k - 1 + t, for k = 1..N
+	discount, err := exact.Div(udecimal.One, f)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	exponent := t
+	price, weighted := udecimal.Zero, udecimal.Zero
+
+	for k := range b.n {
+		flow := b.coupon
+		if k == b.n-1 {
+			flow = flow.Add(hundred)
+		}
+
+		v := exact.Mul(flow, discount)
+		price = price.Add(v)
+		weighted = weighted.Add(exact.Mul(exponent, v))
+
+		if discount, err = exact.Div(discount, base); err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		exponent = exponent.Add(udecimal.One)
+	}
+
+	// d/dyield flow / base^x = -x * flow / base^(x+1) / frequency
+	dp, err := exact.Div(weighted, exact.Mul(base, b.frequency))
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	return price, dp.Neg(), nil
+}
+
+// lastPeriodPrice returns the dirty price of a bond with only 1 coupon left and its derivative with respect to yield,
+// with simple interest, which is the inverse of [bond.lastPeriodYield]:
+//
+//	(100 + coupon) / (1 + yield / frequency * DSC / E)
+//
+// perPeriod is yield / frequency and t is DSC / E.
+func (b bond) lastPeriodPrice(perPeriod, t udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+	den := udecimal.One.Add(exact.Mul(perPeriod, t))
+	if !den.IsPos() {
+		return udecimal.Decimal{}, udecimal.Decimal{}, ErrInvalidRate
+	}
+
+	price, err := exact.Div(hundred.Add(b.coupon), den)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	// d/dyield flow / den = -flow * t / frequency / den^2 = -price * t / (frequency * den)
+	dp, err := exact.Div(exact.Mul(price, t), exact.Mul(b.frequency, den))
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	return price, dp.Neg(), nil
+}
+
+// lastPeriodYield returns the yield of a bond with only 1 coupon left, with simple interest:
+//
+//	(100 + coupon - (price + accrued)) / (price + accrued) * frequency * E / DSC
+func (b bond) lastPeriodYield(price udecimal.Decimal) (udecimal.Decimal, error) {
+	dirty := price.Add(b.accrued())
+
+	gain, err := exact.Div(hundred.Add(b.coupon).Sub(dirty), dirty)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	periods, err := exact.Div(exact.Mul(b.frequency, b.e), b.dsc)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return exact.Mul(gain, periods), nil
+}
+
+// couponDate returns the k-th coupon date before maturity, every 12 / frequency months.
+// If maturity is the last day of its month, the coupon dates are the last day of their month,
+// otherwise they're the day of maturity, or the last day of the month if it's shorter.
+func couponDate(maturity time.Time, k int, frequency Frequency) time.Time {
+	year, month, day := maturity.Date()
+
+	// the first day of the month of the coupon, normalized by time.Date
+	first := time.Date(year, month-time.Month(k*12/int(frequency)), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+
+	if day == daysIn(year, month) || day > last {
+		day = last
+	}
+
+	return first.AddDate(0, 0, day-1)
+}
+
+// daysIn returns the number of days of month in year
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,222 @@
+package finance
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/daycount"
+	"github.com/stretchr/testify/require"
+)
+
+// excelBasis maps the basis argument of the Excel bond functions to a day count convention
+var excelBasis = []daycount.Convention{
+	daycount.Thirty360US,
+	daycount.ActActISDA,
+	daycount.Act360,
+	daycount.Act365Fixed,
+	daycount.Thirty360European,
+}
+
+func (a args) date(t *testing.T, i int) time.Time {
+	t.Helper()
+
+	d, err := time.Parse(time.DateOnly, a[i])
+	require.NoError(t, err)
+
+	return d
+}
+
+func (a args) frequency(t *testing.T, i int) Frequency {
+	t.Helper()
+
+	//nolint:gosec // the frequencies of the test data are small
+	return Frequency(a.int32(t, i))
+}
+
+func (a args) basis(t *testing.T, i int) daycount.Convention {
+	t.Helper()
+	return excelBasis[a.int32(t, i)]
+}
+
+func TestBondReference(t *testing.T) {
+	for _, record := range readTestdata(t, "bond.csv") {
+		fn, a, want, source := record[0], args(strings.Split(record[1], ";")), udecimal.MustParse(record[2]), record[3]
+
+		t.Run(fn+"("+record[1]+")/"+source, func(t *testing.T) {
+			//nolint:gosec // the reference values have less than 19 digits after the decimal point
+			ctx := udecimal.Context{Prec: uint8(want.Prec()), Rounding: udecimal.RoundHalfUp}
+
+			var (
+				got udecimal.Decimal
+				err error
+			)
+
+			switch fn {
+			case "PRICE":
+				got, err = BondPrice(ctx, a.date(t, 0), a.date(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.frequency(t, 4), a.basis(t, 5))
+			case "YIELD":
+				got, err = BondYield(ctx, a.date(t, 0), a.date(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.frequency(t, 4), a.basis(t, 5))
+			default:
+				t.Fatalf("unknown function %s", fn)
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, want.String(), got.String())
+		})
+	}
+}
+
+func TestBond(t *testing.T) {
+	ctx := udecimal.Context{Prec: 10, Rounding: udecimal.RoundHalfUp}
+
+	testcases := []struct {
+		name                 string
+		settlement, maturity string
+		coupon, yield        string
+		frequency            Frequency
+		basis                daycount.Convention
+		clean, dirty         string
+	}{
+		{"30/360 US", "2008-02-15", "2017-11-15", "0.0575", "0.065", SemiAnnual, daycount.Thirty360US, "94.6343616213", "96.0718616213"},
+		{"actual/actual", "2008-02-15", "2017-11-15", "0.0575", "0.065", SemiAnnual, daycount.ActActISDA, "94.6354492079", "96.0887459112"},
+		{"actual/360", "2008-02-15", "2017-11-15", "0.0575", "0.065", SemiAnnual, daycount.Act360, "94.6024171769", "96.0718616213"},
+		{"actual/365", "2008-02-15", "2017-11-15", "0.0575", "0.065", SemiAnnual, daycount.Act365Fixed, "94.6435945483", "96.0929096168"},
+		{"30E/360", "2008-02-15", "2017-11-15", "0.0575", "0.065", SemiAnnual, daycount.Thirty360European, "94.6343616213", "96.0718616213"},
+		{"quarterly, end of month", "2024-03-10", "2034-02-28", "0.04", "0.045", Quarterly, daycount.ActActISDA, "95.9995692857", "96.1082649379"},
+		{"annual, premium", "2024-03-10", "2030-06-30", "0.03", "0.025", Annual, daycount.Thirty360US, "102.8758211984", "104.9591545317"},
+		{"zero coupon", "2024-03-10", "2029-03-10", "0", "0.04", SemiAnnual, daycount.ActActISDA, "82.0348299875", "82.0348299875"},
+		{"settlement on coupon date", "2023-08-31", "2033-02-28", "0.045", "0.05", SemiAnnual, daycount.Thirty360US, "96.2552771643", "96.2552771643"},
+
+		// like in Excel, the price and the yield of the last coupon period use simple interest instead of compounding
+		{"last coupon period", "2024-03-10", "2024-06-30", "0.05", "0.06", SemiAnnual, daycount.ActActISDA, "99.6804554962", "100.6419939577"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			settlement, err := time.Parse(time.DateOnly, tc.settlement)
+			require.NoError(t, err)
+
+			maturity, err := time.Parse(time.DateOnly, tc.maturity)
+			require.NoError(t, err)
+
+			coupon, yield := udecimal.MustParse(tc.coupon), udecimal.MustParse(tc.yield)
+
+			clean, err := BondPrice(ctx, settlement, maturity, coupon, yield, tc.frequency, tc.basis)
+			require.NoError(t, err)
+			require.Equal(t, tc.clean, clean.String())
+
+			dirty, err := BondDirtyPrice(ctx, settlement, maturity, coupon, yield, tc.frequency, tc.basis)
+			require.NoError(t, err)
+			require.Equal(t, tc.dirty, dirty.String())
+
+			got, err := BondYield(ctx, settlement, maturity, coupon, clean, tc.frequency, tc.basis)
+			require.NoError(t, err)
+			require.Equal(t, tc.yield, got.String())
+
+			// round trip: the price at the yield of the price is the price
+			clean2, err := BondPrice(ctx, settlement, maturity, coupon, got, tc.frequency, tc.basis)
+			require.NoError(t, err)
+			require.Equal(t, tc.clean, clean2.String())
+		})
+	}
+}
+
+func TestCouponDate(t *testing.T) {
+	testcases := []struct {
+		maturity  string
+		k         int
+		frequency Frequency
+		want      string
+	}{
+		{"2017-11-15", 0, SemiAnnual, "2017-11-15"},
+		{"2017-11-15", 1, SemiAnnual, "2017-05-15"},
+		{"2017-11-15", 20, SemiAnnual, "2007-11-15"},
+		{"2017-11-15", 3, Quarterly, "2017-02-15"},
+		{"2017-11-15", 2, Annual, "2015-11-15"},
+		{"2034-02-28", 1, Quarterly, "2033-11-30"},
+		{"2034-02-28", 40, Quarterly, "2024-02-29"},
+		{"2033-08-31", 1, SemiAnnual, "2033-02-28"},
+		{"2033-05-30", 1, Quarterly, "2033-02-28"},
+		{"2033-05-30", 2, Quarterly, "2032-11-30"},
+		{"2032-08-29", 1, SemiAnnual, "2032-02-29"},
+		{"2033-08-29", 1, SemiAnnual, "2033-02-28"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.maturity, func(t *testing.T) {
+			maturity, err := time.Parse(time.DateOnly, tc.maturity)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.want, couponDate(maturity, tc.k, tc.frequency).Format(time.DateOnly))
+		})
+	}
+}
+
+func TestBondError(t *testing.T) {
+	ctx := udecimal.Context{Prec: 10}
+	settlement := time.Date(2008, time.February, 15, 0, 0, 0, 0, time.UTC)
+	maturity := time.Date(2017, time.November, 15, 0, 0, 0, 0, time.UTC)
+	coupon, yield, price := udecimal.MustParse("0.0575"), udecimal.MustParse("0.065"), udecimal.MustParse("94.63")
+
+	testcases := []struct {
+		name                 string
+		settlement, maturity time.Time
+		coupon, yield, price udecimal.Decimal
+		frequency            Frequency
+		basis                daycount.Convention
+		wantErr              error
+	}{
+		{"maturity before settlement", maturity, settlement, coupon, yield, price, SemiAnnual, daycount.Thirty360US, ErrInvalidMaturity},
+		{"maturity at settlement", settlement, settlement, coupon, yield, price, SemiAnnual, daycount.Thirty360US, ErrInvalidMaturity},
+		{"invalid frequency", settlement, maturity, coupon, yield, price, 3, daycount.Thirty360US, ErrInvalidFrequency},
+		{"zero frequency", settlement, maturity, coupon, yield, price, 0, daycount.Thirty360US, ErrInvalidFrequency},
+		{"invalid basis", settlement, maturity, coupon, yield, price, SemiAnnual, daycount.Thirty360European + 1, daycount.ErrInvalidConvention},
+		{"negative coupon", settlement, maturity, udecimal.MustParse("-0.01"), yield, price, SemiAnnual, daycount.Thirty360US, ErrInvalidRate},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := BondPrice(ctx, tc.settlement, tc.maturity, tc.coupon, tc.yield, tc.frequency, tc.basis)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			_, err = BondDirtyPrice(ctx, tc.settlement, tc.maturity, tc.coupon, tc.yield, tc.frequency, tc.basis)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			_, err = BondYield(ctx, tc.settlement, tc.maturity, tc.coupon, tc.price, tc.frequency, tc.basis)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	// 1 + yield / frequency must be positive
+	_, err := BondPrice(ctx, settlement, maturity, coupon, udecimal.MustParse("-2"), SemiAnnual, daycount.Thirty360US)
+	require.ErrorIs(t, err, ErrInvalidRate)
+
+	_, err = BondYield(ctx, settlement, maturity, coupon, udecimal.Zero, SemiAnnual, daycount.Thirty360US)
+	require.ErrorIs(t, err, ErrInvalidPrice)
+
+	_, err = BondYield(ctx, settlement, maturity, coupon, udecimal.MustParse("-94.63"), SemiAnnual, daycount.Thirty360US)
+	require.ErrorIs(t, err, ErrInvalidPrice)
+}
+
+func TestSolverBondYield(t *testing.T) {
+	ctx := udecimal.Context{Prec: 6, Rounding: udecimal.RoundHalfUp}
+	settlement := time.Date(2008, time.February, 15, 0, 0, 0, 0, time.UTC)
+	maturity := time.Date(2016, time.November, 15, 0, 0, 0, 0, time.UTC)
+	coupon, price := udecimal.MustParse("0.0575"), udecimal.MustParse("95.04287")
+
+	// a loose tolerance is enough for 6 digits
+	s := Solver{Tolerance: udecimal.MustParse("0.00000001"), MaxIterations: 10}
+
+	got, err := s.BondYield(ctx, settlement, maturity, coupon, price, SemiAnnual, daycount.Thirty360US)
+	require.NoError(t, err)
+	require.Equal(t, "0.065", got.String())
+
+	// a price far above the sum of the cash flows needs a very negative yield
+	_, err = Solver{MaxIterations: 1}.BondYield(ctx, settlement, maturity, coupon, udecimal.MustParse("100000"), SemiAnnual, daycount.Thirty360US)
+
+	var cerr *ConvergenceError
+	require.ErrorAs(t, err, &cerr)
+	require.ErrorIs(t, err, ErrNoConvergence)
+}
@@ -0,0 +1,238 @@
+package finance
+
+import (
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/daycount"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+var daysPerYear = udecimal.MustFromInt64(365, 0)
+
+// DatedFlow is a cash flow at a specific date, used by [XNPV] and [XIRR].
+// Only the date part of Date (in its own location) is used.
+type DatedFlow struct {
+	Date   time.Time
+	Amount udecimal.Decimal
+}
+
+// NPV returns the net present value of periodic cash flows discounted at rate,
+// like NPV(rate, value1, value2, ...) in Excel.
+//
+// Like in Excel, the first cash flow is discounted by one period: NPV = sum(flows[i] / (1 + rate)^(i+1)).
+// For an initial investment made now, add it to the result instead of passing it in flows.
+//
+// Example:
+//
+//	NPV({Prec: 2, Rounding: RoundHalfUp}, 0.1, [-10000, 3000, 4200, 6800]) = 1188.44
+func NPV(ctx udecimal.Context, rate udecimal.Decimal, flows []udecimal.Decimal) (udecimal.Decimal, error) {
+	base := udecimal.One.Add(rate)
+	npv := udecimal.Zero
+
+	for i, flow := range flows {
+		//nolint:gosec // the number of cash flows is far below math.MaxInt32
+		f, err := exact.PowInt32(base, int32(i+1))
+		if err != nil {
+			return udecimal.Decimal{}, err
+		}
+
+		v, err := exact.Div(flow, f)
+		if err != nil {
+			return udecimal.Decimal{}, err
+		}
+
+		npv = npv.Add(v)
+	}
+
+	return round(ctx, npv)
+}
+
+// IRR returns the internal rate of return of periodic cash flows, the rate for which
+// sum(flows[i] / (1 + rate)^i) = 0, like IRR(values, guess) in Excel. Excel uses 0.1 when guess is omitted.
+// It's equivalent to Solver{}.IRR, see [Solver.IRR].
+//
+// Example:
+//
+//	IRR({Prec: 4, Rounding: RoundHalfUp}, [-70000, 12000, 15000, 18000, 21000, 26000], 0.1) = 0.0866
+func IRR(ctx udecimal.Context, flows []udecimal.Decimal, guess udecimal.Decimal) (udecimal.Decimal, error) {
+	return Solver{}.IRR(ctx, flows, guess)
+}
+
+// IRR returns the internal rate of return of periodic cash flows, the rate for which
+// sum(flows[i] / (1 + rate)^i) = 0, like IRR(values, guess) in Excel.
+//
+// The rate is found iteratively starting from guess. When there are several rates,
+// the result is usually the closest one to guess. If no rate is found, a [*ConvergenceError] is returned.
+//
+// Returns [ErrNoSolution] if flows doesn't have both a positive and a negative value.
+func (s Solver) IRR(ctx udecimal.Context, flows []udecimal.Decimal, guess udecimal.Decimal) (udecimal.Decimal, error) {
+	if !hasSignChange(len(flows), func(i int) udecimal.Decimal { return flows[i] }) {
+		return udecimal.Decimal{}, ErrNoSolution
+	}
+
+	rate, err := s.solve(guess, func(rate udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+		return irrEquation(rate, flows)
+	})
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, rate)
+}
+
+// XNPV returns the net present value of cash flows at irregular dates discounted at rate,
+// like XNPV(rate, values, dates) in Excel:
+//
+//	XNPV = sum(flows[i].Amount / (1 + rate)^(days(flows[0].Date, flows[i].Date) / 365))
+//
+// Returns [ErrInvalidDate] if a cash flow is dated before the first one.
+//
+// Example:
+//
+//	XNPV({Prec: 2, Rounding: RoundHalfUp}, 0.09, [{2008-01-01, -10000}, {2008-03-01, 2750}, {2008-10-30, 4250}, {2009-02-15, 3250}, {2009-04-01, 2750}]) = 2086.65
+func XNPV(ctx udecimal.Context, rate udecimal.Decimal, flows []DatedFlow) (udecimal.Decimal, error) {
+	years, err := yearFractions(flows)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	xnpv, _, err := xirrEquation(rate, flows, years)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, xnpv)
+}
+
+// XIRR returns the internal rate of return of cash flows at irregular dates, the rate for which XNPV = 0,
+// like XIRR(values, dates, guess) in Excel. Excel uses 0.1 when guess is omitted.
+// It's equivalent to Solver{}.XIRR, see [Solver.XIRR].
+//
+// Example:
+//
+//	XIRR({Prec: 9, Rounding: RoundHalfUp}, [{2008-01-01, -10000}, {2008-03-01, 2750}, {2008-10-30, 4250}, {2009-02-15, 3250}, {2009-04-01, 2750}], 0.1) = 0.373362534
+func XIRR(ctx udecimal.Context, flows []DatedFlow, guess udecimal.Decimal) (udecimal.Decimal, error) {
+	return Solver{}.XIRR(ctx, flows, guess)
+}
+
+// XIRR returns the internal rate of return of cash flows at irregular dates, the rate for which XNPV = 0,
+// like XIRR(values, dates, guess) in Excel.
+//
+// The rate is found iteratively starting from guess. If no rate is found, a [*ConvergenceError] is returned.
+//
+// Returns error if:
+//   - flows doesn't have both a positive and a negative value: [ErrNoSolution]
+//   - a cash flow is dated before the first one: [ErrInvalidDate]
+func (s Solver) XIRR(ctx udecimal.Context, flows []DatedFlow, guess udecimal.Decimal) (udecimal.Decimal, error) {
+	if !hasSignChange(len(flows), func(i int) udecimal.Decimal { return flows[i].Amount }) {
+		return udecimal.Decimal{}, ErrNoSolution
+	}
+
+	years, err := yearFractions(flows)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	rate, err := s.solve(guess, func(rate udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+		return xirrEquation(rate, flows, years)
+	})
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, rate)
+}
+
+// irrEquation returns sum(flows[i] / (1 + rate)^i) and its derivative with respect to rate
+func irrEquation(rate udecimal.Decimal, flows []udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+	base := udecimal.One.Add(rate)
+	if !base.IsPos() {
+		return udecimal.Decimal{}, udecimal.Decimal{}, errOutOfDomain
+	}
+
+	y, dy := udecimal.Zero, udecimal.Zero
+
+	for i, flow := range flows {
+		//nolint:gosec // the number of cash flows is far below math.MaxInt32
+		f, err := exact.PowInt32(base, int32(i))
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		v, err := exact.Div(flow, f)
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		// d/drate flow / (1 + rate)^i = -i * flow / (1 + rate)^(i+1)
+		dv, err := exact.Div(exact.Mul(udecimal.MustFromInt64(int64(i), 0), v), base)
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		y, dy = y.Add(v), dy.Sub(dv)
+	}
+
+	return y, dy, nil
+}
+
+// xirrEquation returns sum(flows[i].Amount / (1 + rate)^years[i]) and its derivative with respect to rate
+func xirrEquation(rate udecimal.Decimal, flows []DatedFlow, years []udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+	base := udecimal.One.Add(rate)
+	if !base.IsPos() {
+		return udecimal.Decimal{}, udecimal.Decimal{}, errOutOfDomain
+	}
+
+	y, dy := udecimal.Zero, udecimal.Zero
+
+	for i, flow := range flows {
+		f, err := exact.Pow(base, years[i])
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		v, err := exact.Div(flow.Amount, f)
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		// d/drate amount / (1 + rate)^t = -t * amount / (1 + rate)^(t+1)
+		dv, err := exact.Div(exact.Mul(years[i], v), base)
+		if err != nil {
+			return udecimal.Decimal{}, udecimal.Decimal{}, err
+		}
+
+		y, dy = y.Add(v), dy.Sub(dv)
+	}
+
+	return y, dy, nil
+}
+
+// yearFractions returns the number of days between the first cash flow and each cash flow, divided by 365
+func yearFractions(flows []DatedFlow) ([]udecimal.Decimal, error) {
+	years := make([]udecimal.Decimal, len(flows))
+
+	for i, flow := range flows {
+		days := daycount.Days(flows[0].Date, flow.Date)
+		if days < 0 {
+			return nil, ErrInvalidDate
+		}
+
+		// never fails because 365 is not zero
+		years[i], _ = exact.Div(udecimal.MustFromInt64(days, 0), daysPerYear)
+	}
+
+	return years, nil
+}
+
+// hasSignChange returns true if the n values returned by at have both a positive and a negative value
+func hasSignChange(n int, at func(i int) udecimal.Decimal) bool {
+	var pos, neg bool
+	for i := range n {
+		pos = pos || at(i).IsPos()
+		neg = neg || at(i).IsNeg()
+	}
+
+	return pos && neg
+}
@@ -0,0 +1,259 @@
+package finance
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func (a args) flows(i int) []udecimal.Decimal {
+	fields := strings.Fields(a[i])
+	flows := make([]udecimal.Decimal, len(fields))
+	for j, f := range fields {
+		flows[j] = udecimal.MustParse(f)
+	}
+
+	return flows
+}
+
+// datedFlows parses cash flows written as amount@date, separated by spaces
+func datedFlows(t *testing.T, s string) []DatedFlow {
+	var flows []DatedFlow
+	for _, f := range strings.Fields(s) {
+		amount, date, _ := strings.Cut(f, "@")
+
+		d, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			d, err = time.Parse(time.RFC3339, date)
+		}
+
+		require.NoError(t, err)
+		flows = append(flows, DatedFlow{Date: d, Amount: udecimal.MustParse(amount)})
+	}
+
+	return flows
+}
+
+func TestCashFlowReference(t *testing.T) {
+	for _, record := range readTestdata(t, "cashflow.csv") {
+		fn, a, want, source := record[0], args(strings.Split(record[1], ";")), udecimal.MustParse(record[2]), record[3]
+
+		t.Run(fn+"("+record[1]+")/"+source, func(t *testing.T) {
+			//nolint:gosec // the reference values have less than 19 digits after the decimal point
+			ctx := udecimal.Context{Prec: uint8(want.Prec()), Rounding: udecimal.RoundHalfUp}
+
+			var (
+				got udecimal.Decimal
+				err error
+			)
+
+			switch fn {
+			case "NPV":
+				got, err = NPV(ctx, a.decimal(t, 0), a.flows(1))
+			case "IRR":
+				got, err = IRR(ctx, a.flows(0), a.decimal(t, 1))
+			case "XNPV":
+				got, err = XNPV(ctx, a.decimal(t, 0), datedFlows(t, a[1]))
+			case "XIRR":
+				got, err = XIRR(ctx, datedFlows(t, a[0]), a.decimal(t, 1))
+			default:
+				t.Fatalf("unknown function %s", fn)
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, want.String(), got.String())
+		})
+	}
+}
+
+func TestNPV(t *testing.T) {
+	ctx := udecimal.Context{Prec: 10, Rounding: udecimal.RoundHalfUp}
+
+	testcases := []struct {
+		rate    string
+		flows   string
+		want    string
+		wantErr error
+	}{
+		{"0.1", "-10000 3000 4200 6800", "1188.4434123352", nil},
+		{"0", "-10000 3000 4200 6800", "4000", nil},
+		{"0.05", "", "0", nil},
+		{"0.05", "105", "100", nil},
+		{"-0.5", "1 1", "6", nil},
+		{"-1", "1 1", "", udecimal.ErrDivideByZero},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s", tc.rate, tc.flows), func(t *testing.T) {
+			got, err := NPV(ctx, udecimal.MustParse(tc.rate), args{tc.flows}.flows(0))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestIRR(t *testing.T) {
+	ctx := udecimal.Context{Prec: 10, Rounding: udecimal.RoundHalfUp}
+
+	testcases := []struct {
+		flows   string
+		guess   string
+		want    string
+		wantErr error
+	}{
+		{"-70000 12000 15000 18000 21000 26000", "0.1", "0.086630948", nil},
+		{"-70000 12000 15000 18000 21000", "0.1", "-0.0212448483", nil},
+		{"-70000 12000 15000", "-0.1", "-0.4435069413", nil},
+		{"-100 110", "0", "0.1", nil},
+		{"-100 0 121", "0.1", "0.1", nil},
+		{"-100 100", "0.5", "0", nil},
+
+		// Newton's method leaves the domain from these guesses, bisection finds the rate
+		{"-70000 12000 15000", "5", "-0.4435069413", nil},
+		{"-100 300", "-0.99", "2", nil},
+
+		// 2 rates: 10% and 20%, the closest one to guess is returned
+		{"-100 230 -132", "0.05", "0.1", nil},
+		{"-100 230 -132", "0.25", "0.2", nil},
+
+		{"100 200 300", "0.1", "", ErrNoSolution},
+		{"-100 0", "0.1", "", ErrNoSolution},
+		{"", "0.1", "", ErrNoSolution},
+
+		// no rate for which the NPV is zero: -100 + 50/(1+r) - 100/(1+r)^2 < 0 for all r > -1
+		{"-100 50 -100", "0.1", "", ErrNoConvergence},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s", tc.flows, tc.guess), func(t *testing.T) {
+			got, err := IRR(ctx, args{tc.flows}.flows(0), udecimal.MustParse(tc.guess))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestXIRR(t *testing.T) {
+	ctx := udecimal.Context{Prec: 10, Rounding: udecimal.RoundHalfUp}
+
+	testcases := []struct {
+		flows   string
+		guess   string
+		want    string
+		xnpv    string
+		wantErr error
+	}{
+		{
+			flows: "-10000@2008-01-01 2750@2008-03-01 4250@2008-10-30 3250@2009-02-15 2750@2009-04-01",
+			guess: "0.1",
+			want:  "0.3733625335",
+			xnpv:  "2086.6476020315",
+		},
+		{
+			// the dates don't need to be sorted, only the first one is the start
+			flows: "-10000@2008-01-01 2750@2009-04-01 4250@2008-10-30 3250@2009-02-15 2750@2008-03-01",
+			guess: "0.1",
+			want:  "0.3733625335",
+			xnpv:  "2086.6476020315",
+		},
+		{
+			// 1 year exactly
+			flows: "-1000@2021-03-01 1100@2022-03-01",
+			guess: "0",
+			want:  "0.1",
+			xnpv:  "9.1743119266",
+		},
+		{
+			// the time of the day doesn't matter
+			flows: "-1000@2021-03-01T23:59:59Z 1100@2022-03-01T00:00:01Z",
+			guess: "0",
+			want:  "0.1",
+			xnpv:  "9.1743119266",
+		},
+		{
+			flows:   "1000@2021-03-01 1100@2022-03-01",
+			guess:   "0.1",
+			wantErr: ErrNoSolution,
+		},
+		{
+			flows:   "-1000@2021-03-01 1100@2021-02-28",
+			guess:   "0.1",
+			wantErr: ErrInvalidDate,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s", tc.flows, tc.guess), func(t *testing.T) {
+			flows := datedFlows(t, tc.flows)
+
+			got, err := XIRR(ctx, flows, udecimal.MustParse(tc.guess))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+
+			xnpv, err := XNPV(ctx, udecimal.MustParse("0.09"), flows)
+			require.NoError(t, err)
+			require.Equal(t, tc.xnpv, xnpv.String())
+		})
+	}
+}
+
+func TestXNPVInvalidDate(t *testing.T) {
+	_, err := XNPV(udecimal.Context{Prec: 2}, udecimal.MustParse("0.1"), datedFlows(t, "-1000@2021-03-01 1100@2021-02-28"))
+	require.ErrorIs(t, err, ErrInvalidDate)
+
+	got, err := XNPV(udecimal.Context{Prec: 2}, udecimal.MustParse("0.1"), nil)
+	require.NoError(t, err)
+	require.Equal(t, "0", got.String())
+}
+
+func TestSolver(t *testing.T) {
+	ctx := udecimal.Context{Prec: 18, Rounding: udecimal.RoundHalfUp}
+	flows := args{"-70000 12000 15000 18000 21000 26000"}.flows(0)
+	guess := udecimal.MustParse("0.1")
+
+	// a larger tolerance stops earlier
+	got, err := Solver{Tolerance: udecimal.MustParse("0.001")}.IRR(ctx, flows, guess)
+	require.NoError(t, err)
+	require.NotEqual(t, "0.086630948036531614", got.String())
+
+	diff := got.Sub(udecimal.MustParse("0.086630948036531614")).Abs()
+	require.True(t, diff.LessThan(udecimal.MustParse("0.001")), diff.String())
+
+	got, err = Solver{}.IRR(ctx, flows, guess)
+	require.NoError(t, err)
+	require.Equal(t, "0.086630948036531614", got.String())
+
+	// not enough iterations
+	_, err = Solver{MaxIterations: 2}.IRR(ctx, flows, guess)
+	require.ErrorIs(t, err, ErrNoConvergence)
+
+	var cerr *ConvergenceError
+	require.ErrorAs(t, err, &cerr)
+	require.Equal(t, 4, cerr.Iterations)
+	require.Equal(t, fmt.Sprintf("no convergence after 4 iterations, last approximation %s", cerr.Last), err.Error())
+
+	_, err = Solver{MaxIterations: 2}.RATE(ctx, 48, udecimal.MustParse("-200"), udecimal.MustParse("8000"), udecimal.Zero, EndOfPeriod, guess)
+	require.ErrorIs(t, err, ErrNoConvergence)
+
+	_, err = Solver{MaxIterations: 2}.XIRR(ctx, datedFlows(t, "-1000@2021-03-01 1234@2022-03-01"), udecimal.MustParse("0.5"))
+	require.ErrorIs(t, err, ErrNoConvergence)
+}
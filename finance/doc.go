@@ -0,0 +1,26 @@
+// Package finance provides spreadsheet-compatible financial functions on [udecimal.Decimal],
+// such as the time value of money functions [PV], [FV], [PMT], [IPMT], [PPMT], [NPER] and [RATE],
+// the cash flow functions [NPV], [IRR], [XNPV] and [XIRR], the bond functions [BondPrice], [BondDirtyPrice]
+// and [BondYield], and the loan amortization schedules of [Amortize].
+//
+// The functions take the same arguments in the same order as their Excel and LibreOffice counterparts,
+// and use the same sign convention: money paid out (e.g. a loan payment or a deposit) is negative
+// and money received (e.g. the loan amount) is positive. The optional "type" argument of the spreadsheet
+// functions is a [Timing].
+//
+// # Precision and rounding
+//
+// Each function takes a [udecimal.Context]. The intermediate results are computed with 19 digits
+// after the decimal point, then the final result is rounded to ctx.Prec digits using ctx.Rounding, e.g.
+//
+//	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfUp}
+//	rate := udecimal.MustParse("0.005") // 6% per year, paid monthly
+//
+//	finance.PMT(ctx, rate, 360, udecimal.MustParse("200000"), udecimal.Zero, finance.EndOfPeriod) // -1199.10
+//
+// # Iterative functions
+//
+// RATE, IRR, XIRR and BondYield have no closed form, they're solved iteratively by a [Solver], starting from a guess.
+// The tolerance and the maximum number of iterations can be configured, and a [*ConvergenceError]
+// is returned when no result is found.
+package finance
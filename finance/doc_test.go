@@ -0,0 +1,141 @@
+package finance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/daycount"
+)
+
+func ExamplePMT() {
+	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfUp}
+
+	// 30-year mortgage of 200000 at 6% per year, paid monthly
+	rate := udecimal.MustParse("0.005")
+	fmt.Println(PMT(ctx, rate, 360, udecimal.MustParse("200000"), udecimal.Zero, EndOfPeriod))
+	fmt.Println(IPMT(ctx, rate, 1, 360, udecimal.MustParse("200000"), udecimal.Zero, EndOfPeriod))
+	fmt.Println(PPMT(ctx, rate, 1, 360, udecimal.MustParse("200000"), udecimal.Zero, EndOfPeriod))
+	// Output:
+	// -1199.1 <nil>
+	// -1000 <nil>
+	// -199.1 <nil>
+}
+
+func ExampleFV() {
+	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfUp}
+
+	// deposit 500 now and 200 at the beginning of each month for 10 months, at 6% per year
+	fmt.Println(FV(ctx, udecimal.MustParse("0.005"), 10, udecimal.MustParse("-200"), udecimal.MustParse("-500"), BeginningOfPeriod))
+	// Output:
+	// 2581.4 <nil>
+}
+
+func ExampleNPER() {
+	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfUp}
+
+	// months to repay 10000 with 250 per month at 1% per month
+	fmt.Println(NPER(ctx, udecimal.MustParse("0.01"), udecimal.MustParse("-250"), udecimal.MustParse("10000"), udecimal.Zero, EndOfPeriod))
+
+	// 50 per month doesn't even cover the interest
+	fmt.Println(NPER(ctx, udecimal.MustParse("0.01"), udecimal.MustParse("-50"), udecimal.MustParse("10000"), udecimal.Zero, EndOfPeriod))
+	// Output:
+	// 51.34 <nil>
+	// 0 no solution
+}
+
+func ExampleRATE() {
+	ctx := udecimal.Context{Prec: 10, Rounding: udecimal.RoundHalfUp}
+
+	// monthly rate of a 4-year loan of 8000 with payments of 200
+	fmt.Println(RATE(ctx, 48, udecimal.MustParse("-200"), udecimal.MustParse("8000"), udecimal.Zero, EndOfPeriod, udecimal.MustParse("0.1")))
+	// Output:
+	// 0.0077014725 <nil>
+}
+
+func ExampleIRR() {
+	ctx := udecimal.Context{Prec: 4, Rounding: udecimal.RoundHalfUp}
+
+	// invest 70000 now, then receive 12000, 15000, 18000, 21000 and 26000 at the end of the next 5 years
+	flows := []udecimal.Decimal{
+		udecimal.MustParse("-70000"), udecimal.MustParse("12000"), udecimal.MustParse("15000"),
+		udecimal.MustParse("18000"), udecimal.MustParse("21000"), udecimal.MustParse("26000"),
+	}
+
+	fmt.Println(IRR(ctx, flows, udecimal.MustParse("0.1")))
+	fmt.Println(NPV(ctx, udecimal.MustParse("0.0866"), flows[1:]))
+	// Output:
+	// 0.0866 <nil>
+	// 70006.3971 <nil>
+}
+
+func ExampleXIRR() {
+	ctx := udecimal.Context{Prec: 4, Rounding: udecimal.RoundHalfUp}
+	date := func(s string) time.Time {
+		d, _ := time.Parse(time.DateOnly, s)
+		return d
+	}
+
+	flows := []DatedFlow{
+		{Date: date("2008-01-01"), Amount: udecimal.MustParse("-10000")},
+		{Date: date("2008-03-01"), Amount: udecimal.MustParse("2750")},
+		{Date: date("2008-10-30"), Amount: udecimal.MustParse("4250")},
+		{Date: date("2009-02-15"), Amount: udecimal.MustParse("3250")},
+		{Date: date("2009-04-01"), Amount: udecimal.MustParse("2750")},
+	}
+
+	fmt.Println(XIRR(ctx, flows, udecimal.MustParse("0.1")))
+	fmt.Println(XNPV(ctx, udecimal.MustParse("0.09"), flows))
+	// Output:
+	// 0.3734 <nil>
+	// 2086.6476 <nil>
+}
+
+func ExampleSolver() {
+	ctx := udecimal.Context{Prec: 4, Rounding: udecimal.RoundHalfUp}
+	flows := []udecimal.Decimal{udecimal.MustParse("-100"), udecimal.MustParse("50"), udecimal.MustParse("-100")}
+
+	// the NPV is negative for any rate
+	_, err := Solver{MaxIterations: 10}.IRR(ctx, flows, udecimal.MustParse("0.1"))
+
+	var cerr *ConvergenceError
+	fmt.Println(errors.As(err, &cerr), errors.Is(err, ErrNoConvergence))
+	// Output:
+	// true true
+}
+
+func ExampleAmortize() {
+	opts := AmortizeOptions{Prec: 2, Rounding: udecimal.RoundHalfUp}
+
+	// loan of 1000 at 12% per year, repaid in 3 monthly payments
+	schedule, err := Amortize(udecimal.MustParse("1000"), udecimal.MustParse("0.12"), 3, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, in := range schedule {
+		fmt.Println(in.Period, in.Payment.StringFixed(2), in.Interest.StringFixed(2), in.Principal.StringFixed(2), in.Balance.StringFixed(2))
+	}
+	// Output:
+	// 1 340.02 10.00 330.02 669.98
+	// 2 340.02 6.70 333.32 336.66
+	// 3 340.03 3.37 336.66 0.00
+}
+
+func ExampleBondPrice() {
+	ctx := udecimal.Context{Prec: 8, Rounding: udecimal.RoundHalfUp}
+	settlement := time.Date(2008, time.February, 15, 0, 0, 0, 0, time.UTC)
+	maturity := time.Date(2017, time.November, 15, 0, 0, 0, 0, time.UTC)
+
+	// 5.75% semi-annual coupon, 6.5% yield, US 30/360
+	coupon, yield := udecimal.MustParse("0.0575"), udecimal.MustParse("0.065")
+
+	clean, _ := BondPrice(ctx, settlement, maturity, coupon, yield, SemiAnnual, daycount.Thirty360US)
+	dirty, _ := BondDirtyPrice(ctx, settlement, maturity, coupon, yield, SemiAnnual, daycount.Thirty360US)
+	fmt.Println(clean, dirty)
+	fmt.Println(BondYield(ctx, settlement, maturity, coupon, clean, SemiAnnual, daycount.Thirty360US))
+	// Output:
+	// 94.63436162 96.07186162
+	// 0.065 <nil>
+}
@@ -0,0 +1,70 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+)
+
+var (
+	// ErrInvalidTiming is returned when the payment timing is neither EndOfPeriod nor BeginningOfPeriod
+	ErrInvalidTiming = fmt.Errorf("invalid timing. Must be EndOfPeriod or BeginningOfPeriod")
+
+	// ErrInvalidPeriod is returned when the number of periods is not positive,
+	// or the period is not between 1 and the number of periods
+	ErrInvalidPeriod = fmt.Errorf("invalid period. nper must be positive and per must be between 1 and nper")
+
+	// ErrNoSolution is returned when there is no number of periods for the given arguments,
+	// or the cash flows of IRR or XIRR don't have both a positive and a negative value
+	ErrNoSolution = fmt.Errorf("no solution")
+
+	// ErrNoConvergence is returned when an iterative function, like RATE or IRR,
+	// doesn't find a solution within the maximum number of iterations. See [ConvergenceError]
+	ErrNoConvergence = fmt.Errorf("no convergence")
+
+	// ErrInvalidDate is returned when a cash flow of XNPV or XIRR is dated before the first one
+	ErrInvalidDate = fmt.Errorf("invalid date. Cash flows can't be dated before the first one")
+
+	// ErrInvalidFrequency is returned when the coupon frequency of a bond is not Annual, SemiAnnual or Quarterly
+	ErrInvalidFrequency = fmt.Errorf("invalid frequency. Must be Annual, SemiAnnual or Quarterly")
+
+	// ErrInvalidMaturity is returned when the maturity of a bond is not after its settlement
+	ErrInvalidMaturity = fmt.Errorf("invalid maturity. Must be after settlement")
+
+	// ErrInvalidRate is returned when the coupon rate of a bond is negative,
+	// or 1 + yield / frequency is not positive
+	ErrInvalidRate = fmt.Errorf("invalid rate. Coupon must not be negative and 1 + yield / frequency must be positive")
+
+	// ErrInvalidPrice is returned when the price of a bond is not positive
+	ErrInvalidPrice = fmt.Errorf("invalid price. Must be positive")
+)
+
+// Timing is when the payments are due in each period.
+// It's the "type" argument of the spreadsheet functions.
+type Timing uint8
+
+const (
+	// EndOfPeriod means payments are due at the end of each period (type = 0), e.g. loan payments
+	EndOfPeriod Timing = iota
+
+	// BeginningOfPeriod means payments are due at the beginning of each period (type = 1), e.g. rent
+	BeginningOfPeriod
+)
+
+func (t Timing) valid() bool {
+	return t <= BeginningOfPeriod
+}
+
+// decimal returns 0 for EndOfPeriod and 1 for BeginningOfPeriod
+func (t Timing) decimal() udecimal.Decimal {
+	if t == BeginningOfPeriod {
+		return udecimal.One
+	}
+
+	return udecimal.Zero
+}
+
+// round rounds the final result d to ctx.Prec digits after the decimal point using ctx.Rounding
+func round(ctx udecimal.Context, d udecimal.Decimal) (udecimal.Decimal, error) {
+	return d.Round(min(ctx.Prec, 19), ctx.Rounding)
+}
@@ -0,0 +1,206 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+const defaultMaxIterations = 100
+
+var (
+	defaultTolerance = udecimal.MustParse("0.000000000000001")
+
+	// errOutOfDomain is returned by the equations when the rate is less than or equal to -100%
+	errOutOfDomain = fmt.Errorf("rate must be greater than -1")
+
+	// brackets are the rates where the bisection looks for a sign change of the equation
+	brackets = []udecimal.Decimal{
+		udecimal.MustParse("-0.99"), udecimal.MustParse("-0.9"), udecimal.MustParse("-0.5"), udecimal.MustParse("-0.2"),
+		udecimal.Zero, udecimal.MustParse("0.2"), udecimal.MustParse("0.5"), udecimal.MustParse("1"),
+		udecimal.MustParse("2"), udecimal.MustParse("5"), udecimal.MustParse("10"),
+	}
+)
+
+// Solver holds the settings of the functions which find their result iteratively: RATE, IRR, XIRR and BondYield.
+//
+// They start with Newton's method from the given guess. If it doesn't converge, e.g. because the guess is
+// too far from the result, they fall back to bisection between rates where the equation changes its sign.
+//
+// The package-level functions, such as [IRR], are equivalent to calling the same method on the zero Solver.
+//
+// Example:
+//
+//	s := finance.Solver{Tolerance: udecimal.MustParse("0.0000001"), MaxIterations: 20}
+//	s.IRR(ctx, flows, udecimal.MustParse("0.1"))
+type Solver struct {
+	// Tolerance is the largest change of the result between 2 iterations at which the solver stops.
+	// The zero value (or a negative value) means 1e-15.
+	Tolerance udecimal.Decimal
+
+	// MaxIterations is the maximum number of iterations of each method before giving up with a [*ConvergenceError].
+	// The zero value (or a negative value) means 100.
+	MaxIterations int
+}
+
+// ConvergenceError is returned when a Solver doesn't find a result within the maximum number of iterations,
+// or there's no result, e.g. when all cash flows of IRR have the same sign.
+//
+// It matches [ErrNoConvergence] with errors.Is.
+type ConvergenceError struct {
+	// Iterations is the number of iterations done by all methods
+	Iterations int
+
+	// Last is the last approximation of the result
+	Last udecimal.Decimal
+}
+
+func (e *ConvergenceError) Error() string {
+	return fmt.Sprintf("%v after %d iterations, last approximation %s", ErrNoConvergence, e.Iterations, e.Last)
+}
+
+// Unwrap returns [ErrNoConvergence]
+func (e *ConvergenceError) Unwrap() error {
+	return ErrNoConvergence
+}
+
+// equation returns the value of a function and its derivative at x
+type equation func(x udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error)
+
+func (s Solver) tolerance() udecimal.Decimal {
+	if !s.Tolerance.IsPos() {
+		return defaultTolerance
+	}
+
+	return s.Tolerance
+}
+
+func (s Solver) maxIterations() int {
+	if s.MaxIterations <= 0 {
+		return defaultMaxIterations
+	}
+
+	return s.MaxIterations
+}
+
+// solve finds a root of fn with Newton's method starting from guess,
+// then with bisection if Newton's method doesn't converge
+func (s Solver) solve(guess udecimal.Decimal, fn equation) (udecimal.Decimal, error) {
+	x, n, ok := s.newton(guess, fn)
+	if ok {
+		return x, nil
+	}
+
+	lo, hi, found := bracket(guess, fn)
+	if !found {
+		return udecimal.Decimal{}, &ConvergenceError{Iterations: n, Last: x}
+	}
+
+	x, m, ok := s.bisect(lo, hi, fn)
+	if !ok {
+		return udecimal.Decimal{}, &ConvergenceError{Iterations: n + m, Last: x}
+	}
+
+	return x, nil
+}
+
+// newton returns the root of fn found with Newton's method and the number of iterations.
+// It stops when fn can't be evaluated, e.g. the rate goes below -100%.
+func (s Solver) newton(guess udecimal.Decimal, fn equation) (udecimal.Decimal, int, bool) {
+	x := guess
+	tolerance := s.tolerance()
+
+	for i := range s.maxIterations() {
+		y, dy, err := fn(x)
+		if err != nil {
+			return x, i, false
+		}
+
+		if y.IsZero() {
+			return x, i + 1, true
+		}
+
+		// dy = 0 means the tangent is flat, there's no next approximation
+		step, err := exact.Div(y, dy)
+		if err != nil {
+			return x, i + 1, false
+		}
+
+		x = x.Sub(step)
+
+		if step.Abs().LessThanOrEqual(tolerance) {
+			return x, i + 1, true
+		}
+	}
+
+	return x, s.maxIterations(), false
+}
+
+// bisect returns the root of fn between lo and hi, where fn(lo) and fn(hi) have different signs,
+// and the number of iterations
+func (s Solver) bisect(lo, hi udecimal.Decimal, fn equation) (udecimal.Decimal, int, bool) {
+	ylo, _, _ := fn(lo)
+	tolerance := s.tolerance()
+
+	for i := range s.maxIterations() {
+		mid, _ := exact.Div64(lo.Add(hi), 2)
+		if hi.Sub(lo).LessThanOrEqual(tolerance) {
+			return mid, i, true
+		}
+
+		y, _, err := fn(mid)
+		if err != nil {
+			return mid, i + 1, false
+		}
+
+		if y.IsZero() {
+			return mid, i + 1, true
+		}
+
+		if y.Sign() == ylo.Sign() {
+			lo, ylo = mid, y
+		} else {
+			hi = mid
+		}
+	}
+
+	mid, _ := exact.Div64(lo.Add(hi), 2)
+	return mid, s.maxIterations(), false
+}
+
+// bracket returns 2 consecutive rates of brackets where fn changes its sign, the closest ones to guess
+func bracket(guess udecimal.Decimal, fn equation) (udecimal.Decimal, udecimal.Decimal, bool) {
+	var (
+		lo, hi, dist udecimal.Decimal
+		found        bool
+
+		prev, yPrev udecimal.Decimal
+		hasPrev     bool
+	)
+
+	for _, x := range brackets {
+		y, _, err := fn(x)
+		if err != nil {
+			continue
+		}
+
+		if hasPrev && y.Sign() != yPrev.Sign() {
+			// distance between guess and the interval [prev, x]
+			d := udecimal.Zero
+			if guess.LessThan(prev) {
+				d = prev.Sub(guess)
+			} else if guess.GreaterThan(x) {
+				d = guess.Sub(x)
+			}
+
+			if !found || d.LessThan(dist) {
+				lo, hi, dist, found = prev, x, d, true
+			}
+		}
+
+		prev, yPrev, hasPrev = x, y, true
+	}
+
+	return lo, hi, found
+}
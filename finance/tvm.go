@@ -0,0 +1,335 @@
+package finance
+
+import (
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+// The time value of money functions solve the equation
+//
+//	pv * (1 + rate)^nper + pmt * (1 + rate * type) * ((1 + rate)^nper - 1) / rate + fv = 0
+//
+// or pv + pmt * nper + fv = 0 when rate is 0, for one of its variables.
+
+// FV returns the future value of an investment with periodic, constant payments and a constant interest rate,
+// like FV(rate, nper, pmt, pv, type) in Excel.
+//
+// Example:
+//
+//	FV({Prec: 2, Rounding: RoundHalfUp}, 0.005, 10, -200, -500, BeginningOfPeriod) = 2581.40
+func FV(ctx udecimal.Context, rate udecimal.Decimal, nper int32, pmt, pv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	fv, err := futureValue(rate, nper, pmt, pv, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, fv)
+}
+
+// PV returns the present value of a series of periodic, constant payments with a constant interest rate,
+// like PV(rate, nper, pmt, fv, type) in Excel.
+//
+// Example:
+//
+//	PV({Prec: 2, Rounding: RoundHalfUp}, 0.08/12, 240, 500, 0, EndOfPeriod) = -59777.15
+func PV(ctx udecimal.Context, rate udecimal.Decimal, nper int32, pmt, fv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	f, a, err := factors(rate, nper, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	// pv = -(fv + pmt * a) / f
+	pv, err := exact.Div(fv.Add(exact.Mul(pmt, a)).Neg(), f)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, pv)
+}
+
+// PMT returns the periodic payment of a loan (or the periodic deposit of an investment)
+// with a constant interest rate, like PMT(rate, nper, pv, fv, type) in Excel.
+//
+// Returns [ErrInvalidPeriod] if nper <= 0.
+//
+// Example:
+//
+//	PMT({Prec: 2, Rounding: RoundHalfUp}, 0.08/12, 10, 10000, 0, EndOfPeriod) = -1037.03
+func PMT(ctx udecimal.Context, rate udecimal.Decimal, nper int32, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	pmt, err := payment(rate, nper, pv, fv, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, pmt)
+}
+
+// IPMT returns the interest part of the payment for the period per (1 <= per <= nper) of a loan or investment
+// with periodic, constant payments and a constant interest rate, like IPMT(rate, per, nper, pv, fv, type) in Excel.
+//
+// Returns [ErrInvalidPeriod] if nper <= 0 or per is not between 1 and nper.
+//
+// Example:
+//
+//	IPMT({Prec: 2, Rounding: RoundHalfUp}, 0.1/12, 1, 36, 8000, 0, EndOfPeriod) = -66.67
+func IPMT(ctx udecimal.Context, rate udecimal.Decimal, per, nper int32, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	ipmt, _, err := interestPayment(rate, per, nper, pv, fv, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, ipmt)
+}
+
+// PPMT returns the principal part of the payment for the period per (1 <= per <= nper) of a loan or investment
+// with periodic, constant payments and a constant interest rate, like PPMT(rate, per, nper, pv, fv, type) in Excel.
+// PPMT + IPMT = PMT for every period.
+//
+// Returns [ErrInvalidPeriod] if nper <= 0 or per is not between 1 and nper.
+//
+// Example:
+//
+//	PPMT({Prec: 2, Rounding: RoundHalfUp}, 0.1/12, 1, 24, 2000, 0, EndOfPeriod) = -75.62
+func PPMT(ctx udecimal.Context, rate udecimal.Decimal, per, nper int32, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	ipmt, pmt, err := interestPayment(rate, per, nper, pv, fv, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, pmt.Sub(ipmt))
+}
+
+// NPER returns the number of periods of a loan or investment with periodic, constant payments
+// and a constant interest rate, like NPER(rate, pmt, pv, fv, type) in Excel.
+// The result usually isn't an integer.
+//
+// Returns [ErrNoSolution] if no number of periods satisfies the arguments,
+// e.g. when the payment doesn't even cover the interest of a loan.
+//
+// Example:
+//
+//	NPER({Prec: 4, Rounding: RoundHalfUp}, 0.01, -100, -1000, 10000, BeginningOfPeriod) = 59.6739
+func NPER(ctx udecimal.Context, rate, pmt, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	if !when.valid() {
+		return udecimal.Decimal{}, ErrInvalidTiming
+	}
+
+	if rate.IsZero() {
+		// nper = -(pv + fv) / pmt
+		if pmt.IsZero() {
+			return udecimal.Decimal{}, ErrNoSolution
+		}
+
+		nper, err := exact.Div(pv.Add(fv).Neg(), pmt)
+		if err != nil {
+			return udecimal.Decimal{}, err
+		}
+
+		return round(ctx, nper)
+	}
+
+	// (1 + rate)^nper = (z - fv) / (z + pv) with z = pmt * (1 + rate * type) / rate
+	z, err := exact.Div(exact.Mul(pmt, udecimal.One.Add(exact.Mul(rate, when.decimal()))), rate)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	ratio, err := exact.Div(z.Sub(fv), z.Add(pv))
+	if err != nil || !ratio.IsPos() {
+		return udecimal.Decimal{}, ErrNoSolution
+	}
+
+	base := udecimal.One.Add(rate)
+	if !base.IsPos() || base.Equal(udecimal.One) {
+		return udecimal.Decimal{}, ErrNoSolution
+	}
+
+	lnRatio, err := exact.Ln(ratio)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	lnBase, err := exact.Ln(base)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	nper, err := exact.Div(lnRatio, lnBase)
+	if err != nil {
+		return udecimal.Decimal{}, ErrNoSolution
+	}
+
+	return round(ctx, nper)
+}
+
+// RATE returns the interest rate per period of a loan or investment with periodic, constant payments,
+// like RATE(nper, pmt, pv, fv, type, guess) in Excel. Excel uses 0.1 when guess is omitted.
+// It's equivalent to Solver{}.RATE, see [Solver.RATE].
+//
+// Example:
+//
+//	RATE({Prec: 10, Rounding: RoundHalfUp}, 48, -200, 8000, 0, EndOfPeriod, 0.1) = 0.0077014725
+func RATE(ctx udecimal.Context, nper int32, pmt, pv, fv udecimal.Decimal, when Timing, guess udecimal.Decimal) (udecimal.Decimal, error) {
+	return Solver{}.RATE(ctx, nper, pmt, pv, fv, when, guess)
+}
+
+// RATE returns the interest rate per period of a loan or investment with periodic, constant payments,
+// like RATE(nper, pmt, pv, fv, type, guess) in Excel.
+//
+// The rate is found iteratively starting from guess. If no rate is found, which can happen
+// when there's no solution or the rate is far from guess, a [*ConvergenceError] is returned.
+//
+// Returns [ErrInvalidPeriod] if nper <= 0.
+func (s Solver) RATE(ctx udecimal.Context, nper int32, pmt, pv, fv udecimal.Decimal, when Timing, guess udecimal.Decimal) (udecimal.Decimal, error) {
+	if !when.valid() {
+		return udecimal.Decimal{}, ErrInvalidTiming
+	}
+
+	if nper <= 0 {
+		return udecimal.Decimal{}, ErrInvalidPeriod
+	}
+
+	rate, err := s.solve(guess, func(rate udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+		return rateEquation(rate, nper, pmt, pv, fv, when)
+	})
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return round(ctx, rate)
+}
+
+// rateEquation returns the value of the time value of money equation and its derivative with respect to rate
+func rateEquation(rate udecimal.Decimal, nper int32, pmt, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, udecimal.Decimal, error) {
+	n := udecimal.MustFromInt64(int64(nper), 0)
+	t := when.decimal()
+
+	if rate.IsZero() {
+		// y = pv + pmt * n + fv
+		// y' = pv * n + pmt * (n * (n - 1) / 2 + type * n)
+		y := pv.Add(exact.Mul(pmt, n)).Add(fv)
+
+		half, _ := exact.Div64(exact.Mul(n, n.Sub(udecimal.One)), 2)
+		dy := exact.Mul(pv, n).Add(exact.Mul(pmt, half.Add(exact.Mul(t, n))))
+
+		return y, dy, nil
+	}
+
+	base := udecimal.One.Add(rate)
+	if !base.IsPos() {
+		return udecimal.Decimal{}, udecimal.Decimal{}, errOutOfDomain
+	}
+
+	// g = (1 + rate)^(nper - 1), f = (1 + rate)^nper
+	g, err := exact.PowInt32(base, nper-1)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	f := exact.Mul(g, base)
+
+	// a = ((1 + rate)^nper - 1) / rate
+	// a' = (nper * (1 + rate)^(nper - 1) - a) / rate
+	a, err := exact.Div(f.Sub(udecimal.One), rate)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	da, err := exact.Div(exact.Mul(n, g).Sub(a), rate)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	// y = pv * f + pmt * (1 + rate * type) * a + fv
+	// y' = pv * nper * g + pmt * (type * a + (1 + rate * type) * a')
+	k := udecimal.One.Add(exact.Mul(rate, t))
+	y := exact.Mul(pv, f).Add(exact.Mul(exact.Mul(pmt, k), a)).Add(fv)
+	dy := exact.Mul(exact.Mul(pv, n), g).Add(exact.Mul(pmt, exact.Mul(t, a).Add(exact.Mul(k, da))))
+
+	return y, dy, nil
+}
+
+// factors returns (1 + rate)^nper and the annuity factor (1 + rate * type) * ((1 + rate)^nper - 1) / rate,
+// which is nper when rate is 0
+func factors(rate udecimal.Decimal, nper int32, when Timing) (udecimal.Decimal, udecimal.Decimal, error) {
+	if !when.valid() {
+		return udecimal.Decimal{}, udecimal.Decimal{}, ErrInvalidTiming
+	}
+
+	if rate.IsZero() {
+		return udecimal.One, udecimal.MustFromInt64(int64(nper), 0), nil
+	}
+
+	f, err := exact.PowInt32(udecimal.One.Add(rate), nper)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	a, err := exact.Div(f.Sub(udecimal.One), rate)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	if when == BeginningOfPeriod {
+		a = exact.Mul(a, udecimal.One.Add(rate))
+	}
+
+	return f, a, nil
+}
+
+// futureValue returns the future value with 19 digits after the decimal point, -(pv * f + pmt * a)
+func futureValue(rate udecimal.Decimal, nper int32, pmt, pv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	f, a, err := factors(rate, nper, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return exact.Mul(pv, f).Add(exact.Mul(pmt, a)).Neg(), nil
+}
+
+// payment returns the payment with 19 digits after the decimal point, -(fv + pv * f) / a
+func payment(rate udecimal.Decimal, nper int32, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, error) {
+	if nper <= 0 {
+		return udecimal.Decimal{}, ErrInvalidPeriod
+	}
+
+	f, a, err := factors(rate, nper, when)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return exact.Div(fv.Add(exact.Mul(pv, f)).Neg(), a)
+}
+
+// interestPayment returns the interest part of the payment for the period per and the payment,
+// both with 19 digits after the decimal point
+func interestPayment(rate udecimal.Decimal, per, nper int32, pv, fv udecimal.Decimal, when Timing) (udecimal.Decimal, udecimal.Decimal, error) {
+	if per < 1 || per > nper {
+		return udecimal.Decimal{}, udecimal.Decimal{}, ErrInvalidPeriod
+	}
+
+	pmt, err := payment(rate, nper, pv, fv, when)
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	// the interest is charged on the balance at the beginning of the period,
+	// which is -fv(per - 1), or -(fv(per - 2) - pmt) when the payments are made at the beginning of each period
+	var balance udecimal.Decimal
+	switch {
+	case when == EndOfPeriod:
+		balance, err = futureValue(rate, per-1, pmt, pv, when)
+	case per == 1:
+		// the first payment is made before any interest is charged
+		return udecimal.Zero, pmt, nil
+	default:
+		balance, err = futureValue(rate, per-2, pmt, pv, when)
+		balance = balance.Sub(pmt)
+	}
+
+	if err != nil {
+		return udecimal.Decimal{}, udecimal.Decimal{}, err
+	}
+
+	return exact.Mul(balance, rate), pmt, nil
+}
@@ -0,0 +1,313 @@
+package finance
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+	"github.com/stretchr/testify/require"
+)
+
+// args holds the arguments of a spreadsheet function, e.g. "0.08/12;10;10000;0;0"
+type args []string
+
+func (a args) decimal(t *testing.T, i int) udecimal.Decimal {
+	num, den, ok := strings.Cut(a[i], "/")
+	if !ok {
+		return udecimal.MustParse(num)
+	}
+
+	d, err := exact.Div(udecimal.MustParse(num), udecimal.MustParse(den))
+	require.NoError(t, err)
+
+	return d
+}
+
+func (a args) int32(t *testing.T, i int) int32 {
+	n, err := strconv.ParseInt(a[i], 10, 32)
+	require.NoError(t, err)
+
+	return int32(n)
+}
+
+func (a args) timing(t *testing.T, i int) Timing {
+	return Timing(a.int32(t, i))
+}
+
+// readTestdata returns the records of a csv file in testdata, without the header
+func readTestdata(t *testing.T, name string) [][]string {
+	f, err := os.Open("testdata/" + name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+
+	records, err := r.ReadAll()
+	require.NoError(t, err)
+
+	return records[1:]
+}
+
+func TestTVMReference(t *testing.T) {
+	for _, record := range readTestdata(t, "tvm.csv") {
+		fn, a, want, source := record[0], args(strings.Split(record[1], ";")), udecimal.MustParse(record[2]), record[3]
+
+		t.Run(fn+"("+record[1]+")/"+source, func(t *testing.T) {
+			//nolint:gosec // the reference values have less than 19 digits after the decimal point
+			ctx := udecimal.Context{Prec: uint8(want.Prec()), Rounding: udecimal.RoundHalfUp}
+
+			var (
+				got udecimal.Decimal
+				err error
+			)
+
+			switch fn {
+			case "PV":
+				got, err = PV(ctx, a.decimal(t, 0), a.int32(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.timing(t, 4))
+			case "FV":
+				got, err = FV(ctx, a.decimal(t, 0), a.int32(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.timing(t, 4))
+			case "PMT":
+				got, err = PMT(ctx, a.decimal(t, 0), a.int32(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.timing(t, 4))
+			case "IPMT":
+				got, err = IPMT(ctx, a.decimal(t, 0), a.int32(t, 1), a.int32(t, 2), a.decimal(t, 3), a.decimal(t, 4), a.timing(t, 5))
+			case "PPMT":
+				got, err = PPMT(ctx, a.decimal(t, 0), a.int32(t, 1), a.int32(t, 2), a.decimal(t, 3), a.decimal(t, 4), a.timing(t, 5))
+			case "NPER":
+				got, err = NPER(ctx, a.decimal(t, 0), a.decimal(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.timing(t, 4))
+			case "RATE":
+				got, err = RATE(ctx, a.int32(t, 0), a.decimal(t, 1), a.decimal(t, 2), a.decimal(t, 3), a.timing(t, 4), a.decimal(t, 5))
+			default:
+				t.Fatalf("unknown function %s", fn)
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, want.String(), got.String())
+		})
+	}
+}
+
+func TestTVM(t *testing.T) {
+	ctx := udecimal.Context{Prec: 10, Rounding: udecimal.RoundHalfUp}
+
+	testcases := []struct {
+		name string
+		fn   func() (udecimal.Decimal, error)
+		want string
+	}{
+		{
+			"FV",
+			func() (udecimal.Decimal, error) {
+				return FV(ctx, udecimal.MustParse("0.005"), 10, udecimal.MustParse("-200"), udecimal.MustParse("-500"), BeginningOfPeriod)
+			},
+			"2581.4033740602",
+		},
+		{
+			"FV zero rate",
+			func() (udecimal.Decimal, error) {
+				return FV(ctx, udecimal.Zero, 12, udecimal.MustParse("-100.5"), udecimal.MustParse("-1000"), BeginningOfPeriod)
+			},
+			"2206",
+		},
+		{
+			"FV zero periods",
+			func() (udecimal.Decimal, error) {
+				return FV(ctx, udecimal.MustParse("0.05"), 0, udecimal.MustParse("-100"), udecimal.MustParse("1000"), EndOfPeriod)
+			},
+			"-1000",
+		},
+		{
+			"PV",
+			func() (udecimal.Decimal, error) {
+				return PV(ctx, udecimal.MustParse("0.005"), 360, udecimal.MustParse("-1199.1"), udecimal.Zero, EndOfPeriod)
+			},
+			"199999.8248178493",
+		},
+		{
+			"PV zero rate",
+			func() (udecimal.Decimal, error) {
+				return PV(ctx, udecimal.Zero, 10, udecimal.MustParse("-100"), udecimal.MustParse("500"), BeginningOfPeriod)
+			},
+			"500",
+		},
+		{
+			"PMT",
+			func() (udecimal.Decimal, error) {
+				return PMT(ctx, udecimal.MustParse("0.005"), 360, udecimal.MustParse("200000"), udecimal.Zero, EndOfPeriod)
+			},
+			"-1199.1010503055",
+		},
+		{
+			"PMT begin",
+			func() (udecimal.Decimal, error) {
+				return PMT(ctx, udecimal.MustParse("0.005"), 360, udecimal.MustParse("200000"), udecimal.Zero, BeginningOfPeriod)
+			},
+			"-1193.1353734383",
+		},
+		{
+			"PMT zero rate",
+			func() (udecimal.Decimal, error) {
+				return PMT(ctx, udecimal.Zero, 3, udecimal.MustParse("1000"), udecimal.Zero, EndOfPeriod)
+			},
+			"-333.3333333333",
+		},
+		{
+			"IPMT begin",
+			func() (udecimal.Decimal, error) {
+				return IPMT(ctx, udecimal.MustParse("0.01"), 2, 12, udecimal.MustParse("10000"), udecimal.Zero, BeginningOfPeriod)
+			},
+			"-91.2030902299",
+		},
+		{
+			"IPMT begin first period",
+			func() (udecimal.Decimal, error) {
+				return IPMT(ctx, udecimal.MustParse("0.01"), 1, 12, udecimal.MustParse("10000"), udecimal.Zero, BeginningOfPeriod)
+			},
+			"0",
+		},
+		{
+			"PPMT begin",
+			func() (udecimal.Decimal, error) {
+				return PPMT(ctx, udecimal.MustParse("0.01"), 2, 12, udecimal.MustParse("10000"), udecimal.Zero, BeginningOfPeriod)
+			},
+			"-788.4878867834",
+		},
+		{
+			"NPER zero rate",
+			func() (udecimal.Decimal, error) {
+				return NPER(ctx, udecimal.Zero, udecimal.MustParse("-300"), udecimal.MustParse("1000"), udecimal.Zero, EndOfPeriod)
+			},
+			"3.3333333333",
+		},
+		{
+			"NPER",
+			func() (udecimal.Decimal, error) {
+				return NPER(ctx, udecimal.MustParse("0.01"), udecimal.MustParse("-100"), udecimal.MustParse("-1000"), udecimal.MustParse("10000"), BeginningOfPeriod)
+			},
+			"59.6738656743",
+		},
+		{
+			"RATE",
+			func() (udecimal.Decimal, error) {
+				return RATE(ctx, 48, udecimal.MustParse("-200"), udecimal.MustParse("8000"), udecimal.Zero, EndOfPeriod, udecimal.MustParse("0.1"))
+			},
+			"0.0077014725",
+		},
+		{
+			"RATE begin",
+			func() (udecimal.Decimal, error) {
+				return RATE(ctx, 10, udecimal.MustParse("-200"), udecimal.MustParse("-500"), udecimal.MustParse("2581.4033740601791537"), BeginningOfPeriod, udecimal.MustParse("0.1"))
+			},
+			"0.005",
+		},
+		{
+			"RATE zero",
+			func() (udecimal.Decimal, error) {
+				return RATE(ctx, 10, udecimal.MustParse("-100"), udecimal.MustParse("1000"), udecimal.Zero, EndOfPeriod, udecimal.Zero)
+			},
+			"0",
+		},
+		{
+			"RATE negative",
+			func() (udecimal.Decimal, error) {
+				return RATE(ctx, 3, udecimal.MustParse("-10"), udecimal.MustParse("900"), udecimal.Zero, EndOfPeriod, udecimal.MustParse("0.1"))
+			},
+			"-0.7562659369",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.fn()
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestTVMError(t *testing.T) {
+	ctx := udecimal.Context{Prec: 2}
+	rate, pv := udecimal.MustParse("0.01"), udecimal.MustParse("1000")
+
+	testcases := []struct {
+		name    string
+		fn      func() (udecimal.Decimal, error)
+		wantErr error
+	}{
+		{"FV invalid timing", func() (udecimal.Decimal, error) { return FV(ctx, rate, 10, udecimal.Zero, pv, 2) }, ErrInvalidTiming},
+		{"PV invalid timing", func() (udecimal.Decimal, error) { return PV(ctx, rate, 10, udecimal.Zero, pv, 2) }, ErrInvalidTiming},
+		{"PMT invalid timing", func() (udecimal.Decimal, error) { return PMT(ctx, rate, 10, pv, udecimal.Zero, 2) }, ErrInvalidTiming},
+		{"NPER invalid timing", func() (udecimal.Decimal, error) { return NPER(ctx, rate, pv, pv, udecimal.Zero, 2) }, ErrInvalidTiming},
+		{"RATE invalid timing", func() (udecimal.Decimal, error) { return RATE(ctx, 10, pv, pv, udecimal.Zero, 2, rate) }, ErrInvalidTiming},
+		{"PMT zero periods", func() (udecimal.Decimal, error) { return PMT(ctx, rate, 0, pv, udecimal.Zero, EndOfPeriod) }, ErrInvalidPeriod},
+		{"PMT negative periods", func() (udecimal.Decimal, error) { return PMT(ctx, rate, -1, pv, udecimal.Zero, EndOfPeriod) }, ErrInvalidPeriod},
+		{"IPMT period 0", func() (udecimal.Decimal, error) { return IPMT(ctx, rate, 0, 10, pv, udecimal.Zero, EndOfPeriod) }, ErrInvalidPeriod},
+		{"IPMT period > nper", func() (udecimal.Decimal, error) { return IPMT(ctx, rate, 11, 10, pv, udecimal.Zero, EndOfPeriod) }, ErrInvalidPeriod},
+		{"PPMT period > nper", func() (udecimal.Decimal, error) { return PPMT(ctx, rate, 11, 10, pv, udecimal.Zero, EndOfPeriod) }, ErrInvalidPeriod},
+		{"RATE zero periods", func() (udecimal.Decimal, error) { return RATE(ctx, 0, pv, pv, udecimal.Zero, EndOfPeriod, rate) }, ErrInvalidPeriod},
+		{"PV rate -100%", func() (udecimal.Decimal, error) { return PV(ctx, udecimal.MustParse("-1"), 10, pv, pv, EndOfPeriod) }, udecimal.ErrDivideByZero},
+		{
+			// the payment doesn't cover the interest
+			"NPER no solution",
+			func() (udecimal.Decimal, error) {
+				return NPER(ctx, rate, udecimal.MustParse("-5"), pv, udecimal.Zero, EndOfPeriod)
+			},
+			ErrNoSolution,
+		},
+		{"NPER zero rate and payment", func() (udecimal.Decimal, error) {
+			return NPER(ctx, udecimal.Zero, udecimal.Zero, pv, udecimal.Zero, EndOfPeriod)
+		}, ErrNoSolution},
+		{"NPER rate -100%", func() (udecimal.Decimal, error) {
+			return NPER(ctx, udecimal.MustParse("-1"), udecimal.MustParse("-5"), pv, udecimal.Zero, EndOfPeriod)
+		}, ErrNoSolution},
+		{
+			// all cash flows are positive
+			"RATE no solution",
+			func() (udecimal.Decimal, error) {
+				return RATE(ctx, 10, udecimal.MustParse("100"), pv, udecimal.Zero, EndOfPeriod, rate)
+			},
+			ErrNoConvergence,
+		},
+		{"invalid rounding", func() (udecimal.Decimal, error) {
+			return FV(udecimal.Context{Rounding: 100}, rate, 10, udecimal.Zero, pv, EndOfPeriod)
+		}, udecimal.ErrInvalidRoundingMode},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.fn()
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestPaymentParts(t *testing.T) {
+	exactCtx := udecimal.Context{Prec: 19}
+	rate, pv := udecimal.MustParse("0.0045"), udecimal.MustParse("250000")
+
+	for _, when := range []Timing{EndOfPeriod, BeginningOfPeriod} {
+		pmt, err := PMT(exactCtx, rate, 120, pv, udecimal.Zero, when)
+		require.NoError(t, err)
+
+		principal := udecimal.Zero
+		for per := int32(1); per <= 120; per++ {
+			ipmt, err := IPMT(exactCtx, rate, per, 120, pv, udecimal.Zero, when)
+			require.NoError(t, err)
+
+			ppmt, err := PPMT(exactCtx, rate, per, 120, pv, udecimal.Zero, when)
+			require.NoError(t, err)
+
+			require.Equal(t, pmt.String(), ipmt.Add(ppmt).String())
+			principal = principal.Add(ppmt)
+		}
+
+		// the principal parts repay the loan
+		total, err := principal.Neg().Round(6, udecimal.RoundHalfUp)
+		require.NoError(t, err)
+		require.Equal(t, pv.String(), total.String())
+	}
+}
@@ -0,0 +1,20 @@
+// Package format formats [udecimal.Decimal] values for humans, following the conventions of a [Locale]:
+// decimal and group separators, group sizes (including the Indian 3-2-2 grouping),
+// currency symbol placement and the style of negative numbers (e.g. parentheses for accounting).
+//
+//	EnUS.FormatCurrency(d, 2) // $1,234,567.89
+//	DeDE.FormatCurrency(d, 2) // 1.234.567,89 €
+//	EnIN.Format(d, 2)         // 12,34,567.89
+//
+// Like udecimal, this package doesn't perform implicit rounding. The number is padded with zeros
+// to the requested precision, but digits beyond it are kept, see [udecimal.Decimal.StringFixed].
+// Round the number first (e.g. with [udecimal.Decimal.RoundBank]) to get a fixed number of digits.
+//
+// The Append methods don't allocate when the coefficient of the number fits in 128 bits
+// and b has enough capacity.
+//
+// # Parsing
+//
+// [ParseLocale] is the lenient counterpart of [udecimal.Parse] for human-entered amounts, e.g. "1.234,50 €",
+// "(12.00)" or "12-". It returns a [*ParseError] with the position of the problem.
+package format
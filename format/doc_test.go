@@ -0,0 +1,62 @@
+package format
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+)
+
+func ExampleLocale_Format() {
+	d := udecimal.MustParse("1234567.891")
+
+	fmt.Println(EnUS.Format(d, 2))
+	fmt.Println(DeDE.Format(d, 2))
+	fmt.Println(EnIN.Format(d, 2))
+	fmt.Println(EnUS.Format(udecimal.MustParse("1234.5"), 2))
+	// Output:
+	// 1,234,567.891
+	// 1.234.567,891
+	// 12,34,567.891
+	// 1,234.50
+}
+
+func ExampleLocale_FormatCurrency() {
+	d := udecimal.MustParse("-1234567.89")
+
+	fmt.Println(EnUS.FormatCurrency(d, 2))
+	fmt.Println(DeDE.FormatCurrency(d, 2))
+
+	accounting := EnUS
+	accounting.Negative = NegativeParens
+	fmt.Println(accounting.FormatCurrency(d, 2))
+	// Output:
+	// -$1,234,567.89
+	// -1.234.567,89 €
+	// ($1,234,567.89)
+}
+
+func ExampleLocale_Append() {
+	b := make([]byte, 0, 64)
+	b = append(b, "total: "...)
+	b = DeDE.AppendCurrency(b, udecimal.MustParse("1234.5"), 2)
+
+	fmt.Println(string(b))
+	// Output:
+	// total: 1.234,50 €
+}
+
+func ExampleParseLocale() {
+	fmt.Println(ParseLocale("1,234.50", EnUS))
+	fmt.Println(ParseLocale("1.234,50 €", DeDE))
+	fmt.Println(ParseLocale("($ 12.00)", EnUS))
+	fmt.Println(ParseLocale("12-", EnUS))
+
+	// "1,5" is most likely 1.5 written for another locale, not 15
+	fmt.Println(ParseLocale("1,5", EnUS))
+	// Output:
+	// 1234.5 <nil>
+	// 1234.5 <nil>
+	// -12 <nil>
+	// -12 <nil>
+	// 0 invalid format: can't parse '1,5' at offset 1: group separator at the wrong position
+}
@@ -0,0 +1,152 @@
+package format
+
+import (
+	"github.com/quagmt/udecimal"
+)
+
+// maxStringU128 is the maximum length of a decimal string with a u128 coefficient (sign + 39 digits + dot + leading 0)
+const maxStringU128 = 42
+
+// Format returns d formatted with l, with at least prec digits after the decimal separator.
+// See [Locale.Append] for more details.
+//
+// Example:
+//
+//	EnUS.Format(1234567.891, 2) = 1,234,567.891
+//	DeDE.Format(-1234567.8, 2) = -1.234.567,80
+func (l Locale) Format(d udecimal.Decimal, prec uint8) string {
+	var buf [64]byte
+	return string(l.Append(buf[:0], d, prec))
+}
+
+// Append appends d formatted with l to b, with at least prec digits after the decimal separator.
+//
+// Like [udecimal.Decimal.StringFixed], the fractional part is padded with zeros if d has less than prec digits,
+// and d is not rounded if it has more digits than prec.
+func (l Locale) Append(b []byte, d udecimal.Decimal, prec uint8) []byte {
+	return l.append(b, d, prec, false)
+}
+
+// FormatCurrency is the same as [Locale.Format], with the currency symbol of l.
+//
+// Example:
+//
+//	EnUS.FormatCurrency(-1234.5, 2) = -$1,234.50
+//	DeDE.FormatCurrency(1234.5, 2) = 1.234,50 €
+func (l Locale) FormatCurrency(d udecimal.Decimal, prec uint8) string {
+	var buf [64]byte
+	return string(l.AppendCurrency(buf[:0], d, prec))
+}
+
+// AppendCurrency is the same as [Locale.Append], with the currency symbol of l.
+func (l Locale) AppendCurrency(b []byte, d udecimal.Decimal, prec uint8) []byte {
+	return l.append(b, d, prec, true)
+}
+
+func (l Locale) append(b []byte, d udecimal.Decimal, prec uint8, withCurrency bool) []byte {
+	var buf [maxStringU128]byte
+
+	// d.AppendText never fails and doesn't allocate if the coefficient fits in u128
+	digits, _ := d.AppendText(buf[:0])
+
+	neg := len(digits) > 0 && digits[0] == '-'
+	if neg {
+		digits = digits[1:]
+	}
+
+	intPart, fracPart := digits, []byte(nil)
+	for i, c := range digits {
+		if c == '.' {
+			intPart, fracPart = digits[:i], digits[i+1:]
+			break
+		}
+	}
+
+	if neg {
+		switch l.Negative {
+		case NegativeParens:
+			b = append(b, '(')
+		case NegativeTrailingMinus:
+			// written at the end
+		default:
+			b = append(b, '-')
+		}
+	}
+
+	if withCurrency && l.CurrencyPosition == CurrencyBefore {
+		b = append(b, l.CurrencySymbol...)
+		b = append(b, l.CurrencySeparator...)
+	}
+
+	b = l.appendGrouped(b, intPart)
+
+	if len(fracPart) > 0 || prec > 0 {
+		if l.DecimalSeparator == "" {
+			b = append(b, '.')
+		} else {
+			b = append(b, l.DecimalSeparator...)
+		}
+
+		b = append(b, fracPart...)
+		for i := len(fracPart); i < int(prec); i++ {
+			b = append(b, '0')
+		}
+	}
+
+	if withCurrency && l.CurrencyPosition == CurrencyAfter {
+		b = append(b, l.CurrencySeparator...)
+		b = append(b, l.CurrencySymbol...)
+	}
+
+	if neg {
+		switch l.Negative {
+		case NegativeParens:
+			b = append(b, ')')
+		case NegativeTrailingMinus:
+			b = append(b, '-')
+		default:
+			// already written at the beginning
+		}
+	}
+
+	return b
+}
+
+// appendGrouped appends the integer digits to b, inserting l.GroupSeparator between groups of l.GroupSizes digits
+func (l Locale) appendGrouped(b []byte, digits []byte) []byte {
+	if l.GroupSeparator == "" || len(l.GroupSizes) == 0 {
+		return append(b, digits...)
+	}
+
+	// find the separator which is the furthest from the decimal separator:
+	// pos is the number of digits after it and n is the number of groups after it
+	pos, n := 0, 0
+	for {
+		size := l.groupSize(n)
+		if size <= 0 || pos+size >= len(digits) {
+			break
+		}
+
+		pos += size
+		n++
+	}
+
+	// the first group may be shorter than the others
+	b = append(b, digits[:len(digits)-pos]...)
+	digits = digits[len(digits)-pos:]
+
+	for i := n - 1; i >= 0; i-- {
+		size := l.groupSize(i)
+
+		b = append(b, l.GroupSeparator...)
+		b = append(b, digits[:size]...)
+		digits = digits[size:]
+	}
+
+	return b
+}
+
+// groupSize returns the size of the i-th group of digits, counting from the decimal separator
+func (l Locale) groupSize(i int) int {
+	return l.GroupSizes[min(i, len(l.GroupSizes)-1)]
+}
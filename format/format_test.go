@@ -0,0 +1,98 @@
+package format
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	accounting := EnUS
+	accounting.Negative = NegativeParens
+
+	trailing := DeDE
+	trailing.Negative = NegativeTrailingMinus
+
+	testcases := []struct {
+		name         string
+		loc          Locale
+		in           string
+		prec         uint8
+		want         string
+		wantCurrency string
+	}{
+		{"en-US", EnUS, "1234567.89", 2, "1,234,567.89", "$1,234,567.89"},
+		{"en-US", EnUS, "-1234567.89", 2, "-1,234,567.89", "-$1,234,567.89"},
+		{"en-US", EnUS, "1234567.8", 2, "1,234,567.80", "$1,234,567.80"},
+		{"en-US", EnUS, "1234567.891", 2, "1,234,567.891", "$1,234,567.891"},
+		{"en-US", EnUS, "1234567", 0, "1,234,567", "$1,234,567"},
+		{"en-US", EnUS, "0", 2, "0.00", "$0.00"},
+		{"en-US", EnUS, "0", 0, "0", "$0"},
+		{"en-US", EnUS, "-0.05", 2, "-0.05", "-$0.05"},
+		{"en-US", EnUS, "123", 2, "123.00", "$123.00"},
+		{"en-US", EnUS, "1234", 2, "1,234.00", "$1,234.00"},
+		{"en-US", EnUS, "123456", 2, "123,456.00", "$123,456.00"},
+		{"de-DE", DeDE, "1234567.89", 2, "1.234.567,89", "1.234.567,89 €"},
+		{"de-DE", DeDE, "-1234567.89", 2, "-1.234.567,89", "-1.234.567,89 €"},
+		{"de-DE", DeDE, "0.5", 2, "0,50", "0,50 €"},
+		{"fr-FR", FrFR, "1234567.89", 2, "1 234 567,89", "1 234 567,89 €"},
+		{"de-CH", DeCH, "-1234567.89", 2, "-1'234'567.89", "-CHF 1'234'567.89"},
+		{"ja-JP", JaJP, "1234567", 0, "1,234,567", "¥1,234,567"},
+		{"en-GB", EnGB, "1234.5", 2, "1,234.50", "£1,234.50"},
+		{"en-IN", EnIN, "1234567.89", 2, "12,34,567.89", "₹12,34,567.89"},
+		{"en-IN", EnIN, "123456789", 0, "12,34,56,789", "₹12,34,56,789"},
+		{"en-IN", EnIN, "1234", 0, "1,234", "₹1,234"},
+		{"en-IN", EnIN, "123", 0, "123", "₹123"},
+		{"en-IN", EnIN, "-12345", 0, "-12,345", "-₹12,345"},
+		{"accounting", accounting, "-1234.5", 2, "(1,234.50)", "($1,234.50)"},
+		{"accounting", accounting, "1234.5", 2, "1,234.50", "$1,234.50"},
+		{"trailing minus", trailing, "-1234.5", 2, "1.234,50-", "1.234,50 €-"},
+		{"trailing minus", trailing, "1234.5", 2, "1.234,50", "1.234,50 €"},
+		{"zero value", Locale{}, "-1234567.891", 5, "-1234567.89100", "-1234567.89100"},
+		{"stop grouping", Locale{GroupSeparator: ",", GroupSizes: []int{3, 0}}, "1234567", 0, "1234,567", "1234,567"},
+		{"no group sizes", Locale{GroupSeparator: ","}, "1234567", 0, "1234567", "1234567"},
+		{"group of 4", Locale{GroupSeparator: "_", GroupSizes: []int{4}}, "123456789", 0, "1_2345_6789", "1_2345_6789"},
+		{
+			"en-US max u128", EnUS, "-34028236692093846346.3374607431768211455", 2,
+			"-34,028,236,692,093,846,346.3374607431768211455",
+			"-$34,028,236,692,093,846,346.3374607431768211455",
+		},
+		{
+			"en-US big int", EnUS, "123456789012345678901234567890123456789012.5", 2,
+			"123,456,789,012,345,678,901,234,567,890,123,456,789,012.50",
+			"$123,456,789,012,345,678,901,234,567,890,123,456,789,012.50",
+		},
+		{
+			"en-IN big int", EnIN, "-123456789012345678901234567890123456789012", 0,
+			"-1,23,45,67,89,01,23,45,67,89,01,23,45,67,89,01,23,45,67,89,012",
+			"-₹1,23,45,67,89,01,23,45,67,89,01,23,45,67,89,01,23,45,67,89,012",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s %d", tc.name, tc.in, tc.prec), func(t *testing.T) {
+			d := udecimal.MustParse(tc.in)
+
+			require.Equal(t, tc.want, tc.loc.Format(d, tc.prec))
+			require.Equal(t, tc.wantCurrency, tc.loc.FormatCurrency(d, tc.prec))
+
+			// append to an existing buffer
+			require.Equal(t, "x="+tc.want, string(tc.loc.Append([]byte("x="), d, tc.prec)))
+			require.Equal(t, "x="+tc.wantCurrency, string(tc.loc.AppendCurrency([]byte("x="), d, tc.prec)))
+		})
+	}
+}
+
+func TestAppendNoAlloc(t *testing.T) {
+	d := udecimal.MustParse("-34028236692093846346.3374607431768211455")
+	b := make([]byte, 0, 128)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		b = EnUS.AppendCurrency(b[:0], d, 2)
+		b = EnIN.Append(b[:0], d, 2)
+	})
+
+	require.Equal(t, float64(0), allocs)
+}
@@ -0,0 +1,132 @@
+package format
+
+// CurrencyPosition is the position of the currency symbol relative to the number.
+type CurrencyPosition uint8
+
+const (
+	// CurrencyBefore places the currency symbol before the number, e.g. $1.23
+	CurrencyBefore CurrencyPosition = iota
+
+	// CurrencyAfter places the currency symbol after the number, e.g. 1,23 €
+	CurrencyAfter
+)
+
+// NegativeStyle is the way negative numbers are written.
+type NegativeStyle uint8
+
+const (
+	// NegativeMinus writes a minus sign before the number (and the currency symbol), e.g. -$1.23
+	NegativeMinus NegativeStyle = iota
+
+	// NegativeParens wraps the number (and the currency symbol) in parentheses, as in accounting, e.g. ($1.23)
+	NegativeParens
+
+	// NegativeTrailingMinus writes a minus sign after the number (and the currency symbol), e.g. $1.23-
+	NegativeTrailingMinus
+)
+
+// Locale describes how numbers are written in a language or region.
+//
+// The zero value formats numbers like [udecimal.Decimal.StringFixed], without grouping.
+type Locale struct {
+	// DecimalSeparator separates the integer and the fractional part, e.g. "." in en-US and "," in de-DE.
+	// Empty means ".".
+	DecimalSeparator string
+
+	// GroupSeparator separates groups of digits in the integer part, e.g. "," in en-US and "." in de-DE.
+	// Empty means no grouping.
+	GroupSeparator string
+
+	// GroupSizes are the sizes of the groups of digits in the integer part, starting from the decimal separator.
+	// The last size is repeated for the rest of the digits, e.g.
+	//
+	//	[3]    -> 1,234,567
+	//	[3, 2] -> 12,34,567 (Indian grouping)
+	//
+	// Empty means no grouping. A size <= 0 stops the grouping, e.g. [3, 0] -> 1234,567
+	GroupSizes []int
+
+	// CurrencySymbol is written by the Currency methods, e.g. "$" or "€"
+	CurrencySymbol string
+
+	// CurrencyPosition is the position of CurrencySymbol relative to the number
+	CurrencyPosition CurrencyPosition
+
+	// CurrencySeparator is written between CurrencySymbol and the number, e.g. " " in de-DE (1,23 €)
+	CurrencySeparator string
+
+	// Negative is the way negative numbers are written
+	Negative NegativeStyle
+}
+
+// Common locales. They are meant to be copied and adjusted if needed, e.g.
+//
+//	accounting := format.EnUS
+//	accounting.Negative = format.NegativeParens
+var (
+	// EnUS is the locale for the United States: -$1,234,567.89
+	EnUS = Locale{
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		GroupSizes:       []int{3},
+		CurrencySymbol:   "$",
+		CurrencyPosition: CurrencyBefore,
+	}
+
+	// EnGB is the locale for the United Kingdom: -£1,234,567.89
+	EnGB = Locale{
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		GroupSizes:       []int{3},
+		CurrencySymbol:   "£",
+		CurrencyPosition: CurrencyBefore,
+	}
+
+	// EnIN is the locale for India, with the 3-2-2 grouping: -₹12,34,567.89
+	EnIN = Locale{
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		GroupSizes:       []int{3, 2},
+		CurrencySymbol:   "₹",
+		CurrencyPosition: CurrencyBefore,
+	}
+
+	// DeDE is the locale for Germany: -1.234.567,89 €
+	DeDE = Locale{
+		DecimalSeparator:  ",",
+		GroupSeparator:    ".",
+		GroupSizes:        []int{3},
+		CurrencySymbol:    "€",
+		CurrencyPosition:  CurrencyAfter,
+		CurrencySeparator: " ",
+	}
+
+	// FrFR is the locale for France: -1 234 567,89 €
+	FrFR = Locale{
+		DecimalSeparator:  ",",
+		GroupSeparator:    " ",
+		GroupSizes:        []int{3},
+		CurrencySymbol:    "€",
+		CurrencyPosition:  CurrencyAfter,
+		CurrencySeparator: " ",
+	}
+
+	// DeCH is the locale for Switzerland: -CHF 1'234'567.89
+	DeCH = Locale{
+		DecimalSeparator:  ".",
+		GroupSeparator:    "'",
+		GroupSizes:        []int{3},
+		CurrencySymbol:    "CHF",
+		CurrencyPosition:  CurrencyBefore,
+		CurrencySeparator: " ",
+	}
+
+	// JaJP is the locale for Japan: -¥1,234,567
+	JaJP = Locale{
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		GroupSizes:       []int{3},
+		CurrencySymbol:   "¥",
+		CurrencyPosition: CurrencyBefore,
+	}
+)
@@ -0,0 +1,270 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/quagmt/udecimal"
+)
+
+// ParseError is returned by [ParseLocale] when the input can't be parsed.
+//
+// It embeds the [udecimal.ParseError] with the input, the offset and the reason, and adds a locale-specific
+// description of the problem. It unwraps to the embedded error, so both errors.As(err, &perr) with a
+// *udecimal.ParseError and errors.Is(err, udecimal.ErrInvalidFormat) work as expected.
+type ParseError struct {
+	udecimal.ParseError
+
+	// Detail describes the problem, e.g. "group separator at the wrong position"
+	Detail string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v: can't parse '%s' at offset %d: %s", e.ParseError.Unwrap(), e.Input, e.Offset, e.Detail)
+}
+
+// Unwrap returns the embedded [udecimal.ParseError].
+func (e *ParseError) Unwrap() error {
+	return &e.ParseError
+}
+
+func errParse(s string, offset int, reason udecimal.ParseErrorReason, detail string) error {
+	return &ParseError{
+		ParseError: udecimal.ParseError{Input: s, Offset: offset, Reason: reason},
+		Detail:     detail,
+	}
+}
+
+// ParseLocale parses a human-entered number written with the conventions of loc, e.g.
+//
+//	ParseLocale("1,234.50", EnUS) = 1234.5
+//	ParseLocale("1.234,50 €", DeDE) = 1234.5
+//	ParseLocale("1 234,5", FrFR) = 1234.5
+//	ParseLocale("($ 12.00)", EnUS) = -12
+//	ParseLocale("12-", EnUS) = -12
+//
+// Unlike [udecimal.Parse], it accepts:
+//   - surrounding spaces (including no-break spaces)
+//   - the currency symbol of loc before or after the number, with or without spaces
+//   - negative numbers written with a leading or a trailing minus sign, or in parentheses, regardless of loc.Negative
+//   - the group separator of loc in the integer part. Any space is accepted if the group separator is a space.
+//   - a missing integer or fractional part, e.g. ".5" or "12."
+//
+// The group separators must be at the positions given by loc.GroupSizes, so a number written
+// for another locale isn't silently misread, e.g. "1,5" is rejected by EnUS instead of being parsed as 15.
+//
+// Returns a [*ParseError] with the offset of the problem if s can't be parsed.
+func ParseLocale(s string, loc Locale) (udecimal.Decimal, error) {
+	if s == "" {
+		return udecimal.Decimal{}, errParse(s, 0, udecimal.ReasonEmptyString, udecimal.ReasonEmptyString.String())
+	}
+
+	start, end := 0, len(s)
+
+	var neg, parens, sign, symbol bool
+
+	// strip the prefix: spaces, '(', sign and currency symbol
+prefix:
+	for start < end {
+		r, size := utf8.DecodeRuneInString(s[start:end])
+
+		switch {
+		case isSpace(r):
+		case r == '(' && !parens && !sign && !symbol:
+			parens, neg = true, true
+		case (r == '-' || r == '+' || r == '\u2212') && !sign:
+			if parens {
+				return udecimal.Decimal{}, errParse(s, start, udecimal.ReasonUnexpectedChar, "sign inside parentheses")
+			}
+
+			sign, neg = true, r != '+'
+		case !symbol && loc.CurrencySymbol != "" && strings.HasPrefix(s[start:end], loc.CurrencySymbol):
+			symbol, size = true, len(loc.CurrencySymbol)
+		default:
+			break prefix
+		}
+
+		start += size
+	}
+
+	// strip the suffix: spaces, ')', sign and currency symbol
+suffix:
+	for end > start {
+		r, size := utf8.DecodeLastRuneInString(s[start:end])
+
+		switch {
+		case isSpace(r):
+		case r == ')' && parens:
+			parens = false
+		case (r == '-' || r == '\u2212') && !sign && !neg:
+			sign, neg = true, true
+		case !symbol && loc.CurrencySymbol != "" && strings.HasSuffix(s[start:end], loc.CurrencySymbol):
+			symbol, size = true, len(loc.CurrencySymbol)
+		default:
+			break suffix
+		}
+
+		end -= size
+	}
+
+	if parens {
+		return udecimal.Decimal{}, errParse(s, len(s), udecimal.ReasonUnexpectedEnd, "missing closing parenthesis")
+	}
+
+	return parseBody(s, start, end, neg, loc)
+}
+
+// parseBody parses s[start:end], which must only contain digits, group separators and the decimal separator
+func parseBody(s string, start, end int, neg bool, loc Locale) (udecimal.Decimal, error) {
+	decSep := loc.DecimalSeparator
+	if decSep == "" {
+		decSep = "."
+	}
+
+	var (
+		buf       [64]byte
+		b         = buf[:0]
+		dec       = -1 // offset of the decimal separator
+		nDig      int  // number of digits
+		intDigits int  // number of digits before the decimal separator
+
+		// group separators in the integer part, to check their positions
+		groupsBuf [32]groupSep
+		groups    = groupsBuf[:0]
+	)
+
+	if neg {
+		b = append(b, '-')
+	}
+
+	for i := start; i < end; {
+		c := s[i]
+
+		switch {
+		case c >= '0' && c <= '9':
+			b = append(b, c)
+			nDig++
+			i++
+		case strings.HasPrefix(s[i:end], decSep):
+			if dec >= 0 {
+				return udecimal.Decimal{}, errParse(s, i, udecimal.ReasonUnexpectedChar, "more than one decimal separator")
+			}
+
+			if nDig == 0 {
+				// ".5" -> "0.5"
+				b = append(b, '0')
+			}
+
+			dec, intDigits = i, nDig
+			b = append(b, '.')
+			i += len(decSep)
+		default:
+			size := loc.groupSepLen(s[i:end])
+			if size == 0 {
+				r, _ := utf8.DecodeRuneInString(s[i:end])
+				return udecimal.Decimal{}, errParse(s, i, udecimal.ReasonUnexpectedChar, fmt.Sprintf("unexpected character %q", r))
+			}
+
+			if dec >= 0 {
+				return udecimal.Decimal{}, errParse(s, i, udecimal.ReasonUnexpectedChar, "group separator after the decimal separator")
+			}
+
+			groups = append(groups, groupSep{offset: i, digits: nDig})
+			i += size
+		}
+	}
+
+	if nDig == 0 {
+		return udecimal.Decimal{}, errParse(s, start, udecimal.ReasonUnexpectedEnd, "no digits")
+	}
+
+	if dec < 0 {
+		intDigits = nDig
+	}
+
+	if err := loc.checkGroups(s, groups, intDigits); err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	// "12." -> "12"
+	if b[len(b)-1] == '.' {
+		b = b[:len(b)-1]
+	}
+
+	d, err := udecimal.Parse(string(b))
+	if err != nil {
+		var perr *udecimal.ParseError
+		if !errors.As(err, &perr) {
+			// not caused by the input, e.g. invalid default parse mode
+			return udecimal.Decimal{}, err
+		}
+
+		// the offset in the normalized string isn't meaningful to the caller
+		offset := start
+		if perr.Reason == udecimal.ReasonPrecOutOfRange {
+			// the fractional part only has digits, in s and in b,
+			// so the first extra digit is at the same position after the decimal separator
+			offset = dec + len(decSep) + perr.Offset - strings.IndexByte(string(b), '.') - 1
+		}
+
+		return udecimal.Decimal{}, errParse(s, offset, perr.Reason, perr.Reason.String())
+	}
+
+	return d, nil
+}
+
+// groupSep is a group separator found in the integer part
+type groupSep struct {
+	offset int // offset in the input
+	digits int // number of digits before it
+}
+
+// checkGroups checks that the group separators are at the positions given by l.GroupSizes
+func (l Locale) checkGroups(s string, groups []groupSep, intDigits int) error {
+	// from the decimal separator to the left, every group must have the expected size
+	after := intDigits
+	for i := len(groups) - 1; i >= 0; i-- {
+		size := l.groupSize(len(groups) - 1 - i)
+		if size <= 0 || after-groups[i].digits != size {
+			return errParse(s, groups[i].offset, udecimal.ReasonUnexpectedChar, "group separator at the wrong position")
+		}
+
+		after = groups[i].digits
+	}
+
+	// the first group can be shorter than the others, but not empty or longer
+	if len(groups) > 0 {
+		size := l.groupSize(len(groups))
+		if after == 0 || (size > 0 && after > size) {
+			return errParse(s, groups[0].offset, udecimal.ReasonUnexpectedChar, "group separator at the wrong position")
+		}
+	}
+
+	return nil
+}
+
+// groupSepLen returns the length of the group separator at the beginning of s, or 0 if there is none
+func (l Locale) groupSepLen(s string) int {
+	if l.GroupSeparator == "" || len(l.GroupSizes) == 0 {
+		return 0
+	}
+
+	if strings.HasPrefix(s, l.GroupSeparator) {
+		return len(l.GroupSeparator)
+	}
+
+	// any space is accepted if the group separator is a space
+	sep, _ := utf8.DecodeRuneInString(l.GroupSeparator)
+	if r, size := utf8.DecodeRuneInString(s); isSpace(sep) && isSpace(r) {
+		return size
+	}
+
+	return 0
+}
+
+// isSpace reports whether r is a space, including the no-break spaces used as group separators
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\u00a0' || r == '\u202f'
+}
@@ -0,0 +1,163 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocale(t *testing.T) {
+	testcases := []struct {
+		name       string
+		loc        Locale
+		in         string
+		want       string
+		wantErr    error
+		wantOffset int
+	}{
+		{"en-US", EnUS, "1,234.50", "1234.5", nil, 0},
+		{"en-US", EnUS, "1234.50", "1234.5", nil, 0},
+		{"en-US", EnUS, "1,234,567", "1234567", nil, 0},
+		{"en-US", EnUS, "  1,234.5  ", "1234.5", nil, 0},
+		{"en-US", EnUS, "$1,234.50", "1234.5", nil, 0},
+		{"en-US", EnUS, "$ 12", "12", nil, 0},
+		{"en-US", EnUS, "12 $", "12", nil, 0},
+		{"en-US", EnUS, "-$12", "-12", nil, 0},
+		{"en-US", EnUS, "$-12", "-12", nil, 0},
+		{"en-US", EnUS, "+12", "12", nil, 0},
+		{"en-US", EnUS, "\u221212", "-12", nil, 0},
+		{"en-US", EnUS, "(12.00)", "-12", nil, 0},
+		{"en-US", EnUS, "($ 12.00)", "-12", nil, 0},
+		{"en-US", EnUS, "( 12.00 )", "-12", nil, 0},
+		{"en-US", EnUS, "12-", "-12", nil, 0},
+		{"en-US", EnUS, "$12.5-", "-12.5", nil, 0},
+		{"en-US", EnUS, ".5", "0.5", nil, 0},
+		{"en-US", EnUS, "12.", "12", nil, 0},
+		{"en-US", EnUS, "0", "0", nil, 0},
+		{"en-US", EnUS, "-0.00", "0", nil, 0},
+		{"en-US", EnUS, "123,456,789,012,345,678,901,234,567,890,123,456,789.12", "123456789012345678901234567890123456789.12", nil, 0},
+		{"de-DE", DeDE, "1.234,50", "1234.5", nil, 0},
+		{"de-DE", DeDE, "1.234.567,89 €", "1234567.89", nil, 0},
+		{"de-DE", DeDE, "-1.234,5€", "-1234.5", nil, 0},
+		{"de-DE", DeDE, "1.234,50 €-", "-1234.5", nil, 0},
+		{"de-DE", DeDE, "12,5", "12.5", nil, 0},
+		{"fr-FR", FrFR, "1 234,5", "1234.5", nil, 0},
+		{"fr-FR", FrFR, "1\u00a0234\u00a0567,5\u00a0€", "1234567.5", nil, 0},
+		{"fr-FR", FrFR, "1\u202f234,5", "1234.5", nil, 0},
+		{"de-CH", DeCH, "CHF 1'234.50", "1234.5", nil, 0},
+		{"en-IN", EnIN, "12,34,567.89", "1234567.89", nil, 0},
+		{"en-IN", EnIN, "₹1,23,45,67,890", "1234567890", nil, 0},
+		{"zero value", Locale{}, "1234.5", "1234.5", nil, 0},
+		{"stop grouping", Locale{GroupSeparator: ",", GroupSizes: []int{3, 0}}, "1234,567", "1234567", nil, 0},
+
+		{"empty", EnUS, "", "", udecimal.ErrEmptyString, 0},
+		{"spaces only", EnUS, "   ", "", udecimal.ErrInvalidFormat, 3},
+		{"symbol only", EnUS, "$", "", udecimal.ErrInvalidFormat, 1},
+		{"unexpected character", EnUS, "12a.5", "", udecimal.ErrInvalidFormat, 2},
+		{"two decimal separators", EnUS, "1.2.3", "", udecimal.ErrInvalidFormat, 3},
+		{"group in fraction", EnUS, "1.234,5", "", udecimal.ErrInvalidFormat, 5},
+		{"wrong group size", EnUS, "1,5", "", udecimal.ErrInvalidFormat, 1},
+		{"wrong group size", EnUS, "12,34,567", "", udecimal.ErrInvalidFormat, 2},
+		{"first group too long", EnUS, "1234,567", "", udecimal.ErrInvalidFormat, 4},
+		{"empty group", EnUS, ",234", "", udecimal.ErrInvalidFormat, 0},
+		{"double separator", EnUS, "1,,234", "", udecimal.ErrInvalidFormat, 1},
+		{"wrong indian group", EnIN, "1,234,567", "", udecimal.ErrInvalidFormat, 1},
+		{"us number in de-DE", DeDE, "1,234.50", "", udecimal.ErrInvalidFormat, 5},
+		{"missing parenthesis", EnUS, "(12", "", udecimal.ErrInvalidFormat, 3},
+		{"extra parenthesis", EnUS, "12)", "", udecimal.ErrInvalidFormat, 2},
+		{"sign in parentheses", EnUS, "(-12)", "", udecimal.ErrInvalidFormat, 1},
+		{"two signs", EnUS, "-12-", "", udecimal.ErrInvalidFormat, 3},
+		{"two symbols", EnUS, "$12$$", "", udecimal.ErrInvalidFormat, 3},
+		{"prec out of range", EnUS, "0.12345678901234567890", "", udecimal.ErrPrecOutOfRange, 21},
+		{"prec out of range", DeDE, "-1.234,12345678901234567890 €", "", udecimal.ErrPrecOutOfRange, 26},
+		{"prec out of range", EnUS, "$.12345678901234567890", "", udecimal.ErrPrecOutOfRange, 21},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %q", tc.name, tc.in), func(t *testing.T) {
+			d, err := ParseLocale(tc.in, tc.loc)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+
+				var perr *ParseError
+				require.True(t, errors.As(err, &perr))
+				require.Equal(t, tc.in, perr.Input)
+				require.Equal(t, tc.wantOffset, perr.Offset)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, d.String())
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := ParseLocale("12a", EnUS)
+	require.EqualError(t, err, "invalid format: can't parse '12a' at offset 2: unexpected character 'a'")
+
+	testcases := []struct {
+		in         string
+		wantReason udecimal.ParseErrorReason
+		wantErr    error
+	}{
+		{"", udecimal.ReasonEmptyString, udecimal.ErrEmptyString},
+		{"12a", udecimal.ReasonUnexpectedChar, udecimal.ErrInvalidFormat},
+		{"1,5", udecimal.ReasonUnexpectedChar, udecimal.ErrInvalidFormat},
+		{"(12", udecimal.ReasonUnexpectedEnd, udecimal.ErrInvalidFormat},
+		{"$", udecimal.ReasonUnexpectedEnd, udecimal.ErrInvalidFormat},
+		{"0.12345678901234567890", udecimal.ReasonPrecOutOfRange, udecimal.ErrPrecOutOfRange},
+		{strings.Repeat("1", 201), udecimal.ReasonTooLong, udecimal.ErrMaxStrLen},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			_, err := ParseLocale(tc.in, EnUS)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			// the reason is the same as the one of udecimal.Parse, and can be retrieved from both error types
+			var perr *ParseError
+			require.True(t, errors.As(err, &perr))
+			require.Equal(t, tc.wantReason, perr.Reason)
+
+			var uerr *udecimal.ParseError
+			require.True(t, errors.As(err, &uerr))
+			require.Equal(t, tc.wantReason, uerr.Reason)
+			require.Equal(t, tc.in, uerr.Input)
+		})
+	}
+}
+
+func TestParseLocaleRoundTrip(t *testing.T) {
+	locales := []Locale{EnUS, EnGB, EnIN, DeDE, FrFR, DeCH, JaJP, {}}
+	for _, negative := range []NegativeStyle{NegativeParens, NegativeTrailingMinus} {
+		for _, loc := range []Locale{EnUS, DeDE} {
+			loc.Negative = negative
+			locales = append(locales, loc)
+		}
+	}
+
+	numbers := []string{
+		"0", "1", "-1", "0.5", "-0.05", "12.3", "1234.5", "-1234567.891", "1000000",
+		"-34028236692093846346.3374607431768211455", "123456789012345678901234567890123456789012.5",
+	}
+
+	for _, loc := range locales {
+		for _, n := range numbers {
+			d := udecimal.MustParse(n)
+
+			for _, s := range []string{loc.Format(d, 2), loc.FormatCurrency(d, 2)} {
+				t.Run(s, func(t *testing.T) {
+					got, err := ParseLocale(s, loc)
+					require.NoError(t, err)
+					require.True(t, d.Equal(got), "want %s, got %s", d, got)
+				})
+			}
+		}
+	}
+}
@@ -4,11 +4,14 @@ package udecimal
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"math/rand/v2"
 	"testing"
 
+	ed "github.com/ericlagergren/decimal"
 	ss "github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
@@ -86,6 +89,62 @@ func FuzzParse(f *testing.F) {
 	})
 }
 
+func FuzzParseScientific(f *testing.F) {
+	for _, c := range corpus {
+		f.Add(c.neg, c.hi, c.lo, c.prec, int16(3))
+		f.Add(c.neg, c.hi, c.lo, c.prec, int16(-3))
+	}
+
+	f.Fuzz(func(t *testing.T, neg bool, hi uint64, lo uint64, prec uint8, exp int16) {
+		prec = prec % maxPrec
+
+		a, err := NewFromHiLo(neg, hi, lo, prec)
+		require.NoError(t, err)
+
+		s := fmt.Sprintf("%se%d", a.String(), exp)
+
+		aa, err := ss.NewFromString(s)
+		require.NoError(t, err)
+
+		d, err := Parse(s)
+		switch {
+		case err == nil:
+			require.Equal(t, aa.String(), d.String())
+		case errors.Is(err, ErrPrecOutOfRange):
+			require.False(t, aa.Truncate(int32(maxPrec)).Equal(aa))
+		case errors.Is(err, ErrMaxStrLen):
+			require.Greater(t, len(aa.Abs().BigInt().String()), maxStrLen)
+		default:
+			require.NoError(t, err)
+		}
+	})
+}
+
+func FuzzParseError(f *testing.F) {
+	for _, s := range []string{"", "1.2.3", "12c45", "123.", "-", "1e", "1e+5x", "0.12345678901234567890", "1.5e-19", "1e200"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		_, err := Parse(s)
+		if err == nil {
+			return
+		}
+
+		var perr *ParseError
+		require.ErrorAs(t, err, &perr)
+		require.Equal(t, s, perr.Input)
+		require.GreaterOrEqual(t, perr.Offset, 0)
+		require.LessOrEqual(t, perr.Offset, len(s))
+
+		if perr.Reason == ReasonUnexpectedChar {
+			require.Less(t, perr.Offset, len(s))
+		}
+
+		require.NotEmpty(t, err.Error())
+	})
+}
+
 func FuzzAddDec(f *testing.F) {
 	for _, c := range corpus {
 		for _, d := range corpus {
@@ -120,6 +179,129 @@ func FuzzAddDec(f *testing.F) {
 	})
 }
 
+func FuzzChecked(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		ops := []struct {
+			name      string
+			unchecked Decimal
+			checked   func(Decimal) (Decimal, error)
+		}{
+			{"add", a.Add(b), a.AddChecked},
+			{"sub", a.Sub(b), a.SubChecked},
+			{"mul", a.Mul(b), a.MulChecked},
+		}
+
+		for _, op := range ops {
+			c, err := op.checked(b)
+			if errors.Is(err, ErrOverflow) {
+				require.Greater(t, op.unchecked.coef.GetBig().BitLen(), 128, "%s %s %s", op.name, a, b)
+				continue
+			}
+
+			require.NoError(t, err)
+			require.False(t, c.coef.overflow())
+			require.Equal(t, op.unchecked.String(), c.String(), "%s %s %s", op.name, a, b)
+		}
+	})
+}
+
+func FuzzSaturate(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		ops := []struct {
+			name      string
+			unchecked Decimal
+			sat       func(Decimal) (Decimal, bool)
+		}{
+			{"add", a.Add(b), a.AddSat},
+			{"sub", a.Sub(b), a.SubSat},
+			{"mul", a.Mul(b), a.MulSat},
+		}
+
+		for _, op := range ops {
+			c, sat := op.sat(b)
+			require.False(t, c.coef.overflow())
+
+			if !sat {
+				require.Equal(t, op.unchecked.String(), c.String(), "%s %s %s", op.name, a, b)
+				continue
+			}
+
+			require.Greater(t, op.unchecked.coef.GetBig().BitLen(), 128, "%s %s %s", op.name, a, b)
+			require.Equal(t, Decimal{neg: op.unchecked.neg, coef: bint{u128: max128}, prec: op.unchecked.prec}, c)
+		}
+	})
+}
+
+func FuzzAccumulator(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		acc := NewAccumulator(maxPrec)
+		acc.Add(a)
+		acc.Sub(b)
+		acc.AddProduct(a, b)
+		acc.AddProduct(b, b)
+
+		// products are truncated to 19 digits after the decimal point, same as Mul
+		want := a.Sub(b).Add(a.Mul(b)).Add(b.Mul(b))
+		require.Equal(t, want.String(), acc.Result().String(), "a = %s, b = %s", a, b)
+
+		// compare with shopspring/decimal, truncating to 0 digits after the decimal point
+		aa := ssDecimal(aneg, ahi, alo, aprec)
+		bb := ssDecimal(bneg, bhi, blo, bprec)
+
+		acc = NewAccumulator(0)
+		acc.Add(a)
+		acc.AddProduct(a, b)
+
+		cc := aa.Truncate(0).Add(aa.Mul(bb).Truncate(0))
+		require.Equal(t, cc.String(), acc.Result().String(), "a = %s, b = %s", a, b)
+	})
+}
+
 func FuzzAdd64(f *testing.F) {
 	for _, c := range corpus {
 		for _, d := range corpus {
@@ -382,6 +564,56 @@ func FuzzDiv64(f *testing.F) {
 	})
 }
 
+func FuzzDivRound(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec, uint8(2))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8, prec uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+		prec = prec % (maxPrec + 1)
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		c, err := a.DivRound(b, prec, RoundHalfUp)
+		if b.IsZero() {
+			require.Equal(t, ErrDivideByZero, err)
+			return
+		}
+
+		require.NoError(t, err)
+
+		if c.coef.overflow() {
+			require.NotNil(t, c.coef.bigInt)
+			require.Equal(t, u128{}, c.coef.u128)
+		} else {
+			require.Nil(t, c.coef.bigInt)
+		}
+
+		// shopspring/decimal DivRound rounds half away from zero without intermediate truncation,
+		// so the results must be exactly the same
+		aa := ssDecimal(aneg, ahi, alo, aprec)
+		bb := ssDecimal(bneg, bhi, blo, bprec)
+		cc := aa.DivRound(bb, int32(prec))
+
+		require.Equal(t, 0, c.Cmp(MustParse(cc.String())), "a: %s, b: %s, expected %s, got %s", a, b, cc.String(), c.String())
+
+		// Div64Round must agree with DivRound
+		if bhi == 0 && !bneg && bprec == 0 {
+			c2, err := a.Div64Round(blo, prec, RoundHalfUp)
+			require.NoError(t, err)
+			require.Equal(t, c, c2)
+		}
+	})
+}
+
 func FuzzQuoRem(f *testing.F) {
 	for _, c := range corpus {
 		for _, d := range corpus {
@@ -458,6 +690,59 @@ func FuzzRoundBank(f *testing.F) {
 	})
 }
 
+func FuzzRound(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			roundPrecision := uint8(rand.N(20))
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec, roundPrecision)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8, roundPrecision uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		c := a.Mul(b)
+		cstr := c.String()
+
+		u8Round := uint8(roundPrecision % maxPrec)
+		i32Round := int32(roundPrecision % maxPrec)
+		cc := ss.RequireFromString(cstr)
+
+		// compare with shopspring/decimal for the modes it supports
+		for _, tc := range []struct {
+			mode RoundingMode
+			want ss.Decimal
+		}{
+			{RoundDown, cc.RoundDown(i32Round)},
+			{RoundUp, cc.RoundUp(i32Round)},
+			{RoundCeiling, cc.RoundCeil(i32Round)},
+			{RoundFloor, cc.RoundFloor(i32Round)},
+			{RoundHalfUp, cc.Round(i32Round)},
+			{RoundHalfEven, cc.RoundBank(i32Round)},
+		} {
+			cround, err := c.Round(u8Round, tc.mode)
+			require.NoError(t, err)
+			require.Equal(t, tc.want.String(), cround.String(), "round %s %d %s", c, roundPrecision, tc.mode)
+		}
+
+		// RoundUnnecessary only succeeds when no digit is discarded
+		cround, err := c.Round(u8Round, RoundUnnecessary)
+		if cc.Equal(cc.Truncate(i32Round)) {
+			require.NoError(t, err)
+			require.Equal(t, cstr, cround.String())
+		} else {
+			require.Equal(t, ErrRoundingNecessary, err)
+		}
+	})
+}
+
 func FuzzRoundAwayFromZero(f *testing.F) {
 	for _, c := range corpus {
 		for _, d := range corpus {
@@ -569,6 +854,144 @@ func FuzzCeil(f *testing.F) {
 	})
 }
 
+func FuzzFloorPrec(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			roundPrecision := uint8(rand.N(20))
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec, roundPrecision)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8, roundPrecision uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		c := a.Mul(b)
+		if c.coef.overflow() {
+			require.NotNil(t, c.coef.bigInt)
+			require.Equal(t, u128{}, c.coef.u128)
+		} else {
+			require.Nil(t, c.coef.bigInt)
+		}
+
+		cstr := c.String()
+
+		u8Round := uint8(roundPrecision % maxPrec)
+		cround := c.FloorPrec(u8Round)
+
+		// compare with shopspring/decimal
+		i32Round := int32(roundPrecision % maxPrec)
+		cc := ss.RequireFromString(cstr).RoundFloor(i32Round)
+
+		require.Equal(t, cc.String(), cround.String(), "floorPrec %s %d", c, roundPrecision)
+	})
+}
+
+func FuzzCeilPrec(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			roundPrecision := uint8(rand.N(20))
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec, roundPrecision)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8, roundPrecision uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		b, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		c := a.Mul(b)
+		if c.coef.overflow() {
+			require.NotNil(t, c.coef.bigInt)
+			require.Equal(t, u128{}, c.coef.u128)
+		} else {
+			require.Nil(t, c.coef.bigInt)
+		}
+
+		cstr := c.String()
+
+		u8Round := uint8(roundPrecision % maxPrec)
+		cround := c.CeilPrec(u8Round)
+
+		// compare with shopspring/decimal
+		i32Round := int32(roundPrecision % maxPrec)
+		cc := ss.RequireFromString(cstr).RoundCeil(i32Round)
+
+		require.Equal(t, cc.String(), cround.String(), "ceilPrec %s %d", c, roundPrecision)
+	})
+}
+
+func FuzzRoundToIncrement(f *testing.F) {
+	for _, c := range corpus {
+		for _, d := range corpus {
+			f.Add(c.neg, c.hi, c.lo, c.prec, d.neg, d.hi, d.lo, d.prec, uint8(rand.N(10)))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, aneg bool, ahi uint64, alo uint64, aprec uint8, bneg bool, bhi uint64, blo uint64, bprec uint8, mode uint8) {
+		aprec = aprec % maxPrec
+		bprec = bprec % maxPrec
+		rmode := RoundingMode(mode % uint8(RoundUnnecessary))
+
+		a, err := NewFromHiLo(aneg, ahi, alo, aprec)
+		require.NoError(t, err)
+
+		step, err := NewFromHiLo(bneg, bhi, blo, bprec)
+		require.NoError(t, err)
+
+		c, err := a.RoundToIncrement(step, rmode)
+		if step.IsZero() {
+			require.Equal(t, ErrDivideByZero, err)
+			return
+		}
+
+		require.NoError(t, err)
+
+		// the result must be a multiple of step and less than one step away from a
+		require.True(t, c.IsMultipleOf(step), "%s.roundToIncrement(%s, %s) = %s", a, step, rmode, c)
+		require.Equal(t, -1, c.Sub(a).Abs().Cmp(step.Abs()), "%s.roundToIncrement(%s, %s) = %s", a, step, rmode, c)
+
+		// compare with shopspring/decimal: round the exact quotient to an integer then multiply by step
+		aa := ssDecimal(aneg, ahi, alo, aprec)
+		bb := ssDecimal(false, bhi, blo, bprec)
+		q, r := aa.QuoRem(bb, 0)
+
+		var cc ss.Decimal
+
+		switch rmode {
+		case RoundDown:
+			cc = q.Mul(bb)
+		case RoundFloor:
+			if r.IsNegative() {
+				q = q.Sub(ss.New(1, 0))
+			}
+
+			cc = q.Mul(bb)
+		case RoundCeiling:
+			if r.IsPositive() {
+				q = q.Add(ss.New(1, 0))
+			}
+
+			cc = q.Mul(bb)
+		default:
+			return
+		}
+
+		require.Equal(t, 0, c.Cmp(MustParse(cc.String())), "%s.roundToIncrement(%s, %s) = %s, expected %s", a, step, rmode, c, cc)
+	})
+}
+
 func FuzzTrunc(f *testing.F) {
 	for _, c := range corpus {
 		for _, d := range corpus {
@@ -792,6 +1215,315 @@ func FuzzPowNegative(f *testing.F) {
 	})
 }
 
+// edCompare checks that got is within one unit (1e-19) of the ericlagergren/decimal result
+func edCompare(t *testing.T, got Decimal, want *ed.Big, msgAndArgs ...any) {
+	t.Helper()
+
+	// truncate to our precision, the reference is calculated with many more digits
+	w := Zero
+	if want.CmpAbs(ed.New(1, int(defaultPrec))) >= 0 {
+		edCtx.Quantize(want, int(defaultPrec))
+		w = MustParse(ss.RequireFromString(want.String()).String())
+	}
+
+	require.LessOrEqual(t, got.Sub(w).Abs().Cmp(oneUnit), 0, msgAndArgs...)
+}
+
+var edCtx = ed.Context{Precision: 250, RoundingMode: ed.ToZero}
+
+func FuzzExp(f *testing.F) {
+	f.Add(int64(0), uint8(0))
+	f.Add(int64(1), uint8(0))
+	f.Add(int64(-1), uint8(0))
+	f.Add(int64(12345), uint8(3))
+	f.Add(int64(-12345), uint8(3))
+	f.Add(int64(414), uint8(0))
+	f.Add(int64(-44), uint8(0))
+	f.Add(int64(1234567890123456789), uint8(19))
+
+	f.Fuzz(func(t *testing.T, v int64, prec uint8) {
+		prec = prec % (maxPrec + 1)
+
+		a, err := NewFromInt64(v, prec)
+		require.NoError(t, err)
+
+		c, err := a.Exp()
+		if a.Cmp(MustFromInt64(maxExpArg, 0)) > 0 {
+			require.Equal(t, ErrExpOverflow, err)
+			return
+		}
+
+		require.NoError(t, err)
+
+		x, ok := new(ed.Big).SetString(a.String())
+		require.True(t, ok)
+
+		edCompare(t, c, edCtx.Exp(new(ed.Big), x), "exp(%s) = %s", a, c)
+	})
+}
+
+func FuzzLog(f *testing.F) {
+	for _, c := range corpus {
+		f.Add(c.neg, c.hi, c.lo, c.prec)
+	}
+
+	f.Fuzz(func(t *testing.T, neg bool, hi uint64, lo uint64, prec uint8) {
+		prec = prec % maxPrec
+
+		a, err := NewFromHiLo(neg, hi, lo, prec)
+		require.NoError(t, err)
+
+		ln, err := a.Ln()
+		if !a.IsPos() {
+			require.Equal(t, ErrLogNonPositive, err)
+
+			_, err = a.Log10()
+			require.Equal(t, ErrLogNonPositive, err)
+
+			_, err = a.Log2()
+			require.Equal(t, ErrLogNonPositive, err)
+			return
+		}
+
+		require.NoError(t, err)
+
+		log10, err := a.Log10()
+		require.NoError(t, err)
+
+		log2, err := a.Log2()
+		require.NoError(t, err)
+
+		x, ok := new(ed.Big).SetString(a.String())
+		require.True(t, ok)
+
+		edCompare(t, ln, edCtx.Log(new(ed.Big), x), "ln(%s) = %s", a, ln)
+
+		// log10(x) = ln(x) / ln(10). edCtx.Log10 isn't used because it's wrong for some inputs,
+		// e.g. it returns 5 for 184467.44073709551716
+		ln10 := edCtx.Log(new(ed.Big), ed.New(10, 0))
+		edCompare(t, log10, edCtx.Quo(new(ed.Big), edCtx.Log(new(ed.Big), x), ln10), "log10(%s) = %s", a, log10)
+
+		// log2(x) = ln(x) / ln(2)
+		ln2 := edCtx.Log(new(ed.Big), ed.New(2, 0))
+		edCompare(t, log2, edCtx.Quo(new(ed.Big), edCtx.Log(new(ed.Big), x), ln2), "log2(%s) = %s", a, log2)
+	})
+}
+
+func FuzzPow(f *testing.F) {
+	f.Add(uint64(2), uint8(0), int64(5), uint8(1))
+	f.Add(uint64(105), uint8(2), int64(25), uint8(1))
+	f.Add(uint64(4), uint8(0), int64(-15), uint8(1))
+	f.Add(uint64(12345), uint8(3), int64(123456789), uint8(9))
+	f.Add(uint64(1234567890123456789), uint8(19), int64(-1234567890123456789), uint8(18))
+
+	f.Fuzz(func(t *testing.T, alo uint64, aprec uint8, ev int64, eprec uint8) {
+		aprec = aprec % maxPrec
+		eprec = eprec % maxPrec
+
+		a, err := NewFromHiLo(false, 0, alo, aprec)
+		require.NoError(t, err)
+
+		e, err := NewFromInt64(ev, eprec)
+		require.NoError(t, err)
+
+		c, err := a.Pow(e)
+
+		switch {
+		case e.Trunc(0).Equal(e):
+			// integer exponents are covered by FuzzPowToIntPart
+			return
+		case a.IsZero():
+			if e.IsNeg() {
+				require.Equal(t, ErrZeroPowNegative, err)
+			} else {
+				require.NoError(t, err)
+				require.True(t, c.IsZero())
+			}
+
+			return
+		}
+
+		x, ok := new(ed.Big).SetString(a.String())
+		require.True(t, ok)
+
+		y, ok := new(ed.Big).SetString(e.String())
+		require.True(t, ok)
+
+		if err == ErrExpOverflow {
+			// e * ln(a) must be close to or greater than maxExpArg
+			v := edCtx.Mul(new(ed.Big), edCtx.Log(new(ed.Big), x), y)
+			require.Equal(t, 1, v.Cmp(ed.New(maxExpArg-1, 0)), "%s^%s", a, e)
+			return
+		}
+
+		require.NoError(t, err)
+
+		edCompare(t, c, edCtx.Pow(new(ed.Big), x, y), "%s^%s = %s", a, e, c)
+	})
+}
+
+func FuzzNthRoot(f *testing.F) {
+	for _, c := range corpus {
+		f.Add(c.neg, c.hi, c.lo, c.prec, uint16(3))
+		f.Add(c.neg, c.hi, c.lo, c.prec, uint16(7))
+	}
+
+	f.Fuzz(func(t *testing.T, neg bool, hi uint64, lo uint64, prec uint8, n uint16) {
+		prec = prec % maxPrec
+		root := int(n%50) + 1
+
+		a, err := NewFromHiLo(neg, hi, lo, prec)
+		require.NoError(t, err)
+
+		c, err := a.NthRoot(root)
+		if a.IsNeg() && root%2 == 0 {
+			require.Equal(t, ErrEvenRootNegative, err)
+			return
+		}
+
+		require.NoError(t, err)
+		if !c.IsZero() {
+			require.Equal(t, a.IsNeg(), c.IsNeg(), "nthroot(%s, %d) = %s", a, root, c)
+		}
+
+		// c is truncated, so c^n <= |a| < (c + 10^-19)^n must hold.
+		// Compare with integers: A = |a| * 10^(19*n), C = |c| * 10^19
+		aa := a.coef.GetBig()
+		aa.Mul(aa, new(big.Int).Exp(bigTen, big.NewInt(int64(int(defaultPrec)*root-int(a.prec))), nil))
+
+		cc := c.coef.GetBig()
+		cc.Mul(cc, new(big.Int).Exp(bigTen, big.NewInt(int64(defaultPrec-c.prec)), nil))
+
+		nn := big.NewInt(int64(root))
+		lower := new(big.Int).Exp(cc, nn, nil)
+		upper := new(big.Int).Exp(cc.Add(cc, bigOne), nn, nil)
+
+		require.LessOrEqual(t, lower.Cmp(aa), 0, "nthroot(%s, %d) = %s", a, root, c)
+		require.Equal(t, 1, upper.Cmp(aa), "nthroot(%s, %d) = %s", a, root, c)
+	})
+}
+
+func FuzzAllocate(f *testing.F) {
+	for _, c := range corpus {
+		f.Add(c.neg, c.hi, c.lo, c.prec, uint8(19), uint64(1), uint64(1), uint64(1))
+		f.Add(c.neg, c.hi, c.lo, c.prec, uint8(2), uint64(3), uint64(0), uint64(7))
+	}
+
+	f.Fuzz(func(t *testing.T, neg bool, hi uint64, lo uint64, prec uint8, allocPrec uint8, r1, r2, r3 uint64) {
+		prec = prec % maxPrec
+		allocPrec = prec + allocPrec%(maxPrec-prec+1)
+
+		a, err := NewFromHiLo(neg, hi, lo, prec)
+		require.NoError(t, err)
+
+		ratios := []Decimal{
+			MustFromUint64(r1, 0),
+			MustFromUint64(r2, 3),
+			MustFromUint64(r3, 19),
+		}
+
+		total := ratios[0].Add(ratios[1]).Add(ratios[2])
+
+		parts, err := a.Allocate(allocPrec, ratios...)
+		if total.IsZero() {
+			require.Equal(t, ErrInvalidRatios, err)
+			return
+		}
+
+		require.NoError(t, err)
+
+		// parts must sum up exactly to a, and each part must be less than one unit away from its exact share
+		sum := ss.Zero
+		unit := ss.New(1, -int32(allocPrec))
+		aa := ssDecimal(neg, hi, lo, prec)
+		tt := ss.RequireFromString(total.String())
+
+		for i, p := range parts {
+			require.LessOrEqual(t, p.Prec(), int(allocPrec))
+
+			pp := ss.RequireFromString(p.String())
+			sum = sum.Add(pp)
+
+			share := aa.Mul(ss.RequireFromString(ratios[i].String())).DivRound(tt, 40)
+			require.True(t, pp.Sub(share).Abs().LessThan(unit), "allocate(%s, %d, %v) = %v", a, allocPrec, ratios, parts)
+		}
+
+		require.True(t, aa.Equal(sum), "allocate(%s, %d, %v) = %v", a, allocPrec, ratios, parts)
+
+		// Split is the same as Allocate with equal ratios
+		parts, err = a.Split(3, allocPrec)
+		require.NoError(t, err)
+
+		want, err := a.Allocate(allocPrec, One, One, One)
+		require.NoError(t, err)
+		require.Equal(t, want, parts)
+	})
+}
+
+func FuzzFormat(f *testing.F) {
+	for _, c := range corpus {
+		f.Add(c.neg, c.hi, c.lo, c.prec, uint8(2))
+	}
+
+	f.Fuzz(func(t *testing.T, neg bool, hi uint64, lo uint64, prec uint8, fmtPrec uint8) {
+		prec = prec % maxPrec
+		fmtPrec = fmtPrec % 25
+
+		a, err := NewFromHiLo(neg, hi, lo, prec)
+		require.NoError(t, err)
+
+		aa := ssDecimal(neg, hi, lo, prec)
+
+		require.Equal(t, a.String(), fmt.Sprintf("%v", a))
+
+		// %.Nf uses banker's rounding
+		want := aa.StringFixedBank(int32(fmtPrec))
+		if aa.RoundBank(int32(fmtPrec)).IsZero() {
+			// udecimal doesn't have negative zero
+			want = ss.Zero.StringFixed(int32(fmtPrec))
+		}
+
+		require.Equal(t, want, fmt.Sprintf("%.*f", fmtPrec, a))
+	})
+}
+
+func FuzzStringSci(f *testing.F) {
+	for _, c := range corpus {
+		f.Add(c.neg, c.hi, c.lo, c.prec, uint8(3))
+	}
+
+	f.Fuzz(func(t *testing.T, neg bool, hi uint64, lo uint64, prec uint8, sigDigits uint8) {
+		prec = prec % maxPrec
+
+		a, err := NewFromHiLo(neg, hi, lo, prec)
+		require.NoError(t, err)
+
+		// all digits are kept, so it must be parsed back to the same value
+		b, err := Parse(a.StringSci(0))
+		require.NoError(t, err)
+		require.True(t, a.Equal(b))
+
+		b, err = Parse(a.StringEng(0))
+		require.NoError(t, err)
+		require.True(t, a.Equal(b))
+
+		// both notations must have the same value after rounding to sigDigits
+		if sigDigits > 0 {
+			sci, err := Parse(a.StringSci(sigDigits))
+			require.NoError(t, err)
+
+			eng, err := Parse(a.StringEng(sigDigits))
+			require.NoError(t, err)
+			require.True(t, sci.Equal(eng))
+
+			// %.Ne rounds to N+1 significant digits in the same way
+			want, err := ss.NewFromString(fmt.Sprintf("%.*e", sigDigits-1, a))
+			require.NoError(t, err)
+			require.Equal(t, want.String(), sci.String())
+		}
+	})
+}
+
 func FuzzMarshalJSON(f *testing.F) {
 	for _, c := range corpus {
 		for _, d := range corpus {
@@ -0,0 +1,57 @@
+// Package exact implements the arithmetic of the intermediate results of the stats, finance and daycount packages.
+//
+// The results keep 19 digits after the decimal point, whatever the default precision of udecimal,
+// and integers are multiplied without losing any digit.
+package exact
+
+import "github.com/quagmt/udecimal"
+
+var ctx = udecimal.MaxPrecisionContext()
+
+// Mul returns d * e, truncated to 19 digits after the decimal point.
+// It never fails: it switches to *big.Int when the coefficient doesn't fit in 128 bits.
+func Mul(d, e udecimal.Decimal) udecimal.Decimal {
+	v, _ := ctx.Mul(d, e)
+	return v
+}
+
+// Div returns d / e, truncated to 19 digits after the decimal point
+func Div(d, e udecimal.Decimal) (udecimal.Decimal, error) {
+	return ctx.Div(d, e)
+}
+
+// Div64 returns d / v, truncated to 19 digits after the decimal point
+func Div64(d udecimal.Decimal, v uint64) (udecimal.Decimal, error) {
+	return ctx.Div64(d, v)
+}
+
+// PowInt32 returns d^e, truncated to 19 digits after the decimal point
+func PowInt32(d udecimal.Decimal, e int32) (udecimal.Decimal, error) {
+	return ctx.PowInt32(d, e)
+}
+
+// Pow returns d^e, truncated to 19 digits after the decimal point
+func Pow(d, e udecimal.Decimal) (udecimal.Decimal, error) {
+	return ctx.Pow(d, e)
+}
+
+// Ln returns the natural logarithm of d, truncated to 19 digits after the decimal point
+func Ln(d udecimal.Decimal) (udecimal.Decimal, error) {
+	return ctx.Ln(d)
+}
+
+// Pow10 returns 10^p for p <= 19
+func Pow10(p uint8) udecimal.Decimal {
+	v := uint64(1)
+	for range p {
+		v *= 10
+	}
+
+	return udecimal.MustFromUint64(v, 0)
+}
+
+// Integer returns d * 10^p without digits after the decimal point, where d has at most p <= 19 digits
+// after the decimal point, e.g. Integer(1.25, 3) = 1250
+func Integer(d udecimal.Decimal, p uint8) udecimal.Decimal {
+	return Mul(d, Pow10(p)).Trunc(0)
+}
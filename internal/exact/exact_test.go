@@ -0,0 +1,46 @@
+package exact
+
+import (
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultPrecision(t *testing.T) {
+	defer udecimal.SetDefaultPrecision(19)
+
+	a := udecimal.MustParse("0.1234567890123456789")
+	udecimal.SetDefaultPrecision(2)
+
+	require.Equal(t, "0.0152415787532388367", Mul(a, a).String())
+
+	q, err := Div(udecimal.One, udecimal.MustFromInt64(3, 0))
+	require.NoError(t, err)
+	require.Equal(t, "0.3333333333333333333", q.String())
+}
+
+func TestInteger(t *testing.T) {
+	testcases := []struct {
+		d    string
+		p    uint8
+		want string
+	}{
+		{"1.25", 3, "1250"},
+		{"-1.25", 2, "-125"},
+		{"0", 19, "0"},
+		{"0.0000000000000000001", 19, "1"},
+		{"340282366920938463463374607431768211455.5", 19, "3402823669209384634633746074317682114555000000000000000000"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.d, func(t *testing.T) {
+			got := Integer(udecimal.MustParse(tc.d), tc.p)
+			require.Equal(t, tc.want, got.String())
+			require.Equal(t, 0, got.Prec())
+		})
+	}
+
+	require.Equal(t, "1", Pow10(0).String())
+	require.Equal(t, "10000000000000000000", Pow10(19).String())
+}
@@ -0,0 +1,433 @@
+package udecimal
+
+import (
+	"math/big"
+)
+
+const (
+	// mathGuardDigits is the number of extra digits used by the intermediate fixed-point
+	// calculations of Exp, Ln, Log10 and Log2, so that the truncated result is correct to prec digits
+	mathGuardDigits = 12
+
+	// maxExpArg is the largest argument accepted by Exp.
+	// e^414 has 180 integer digits, so with 19 digits after the decimal point the result
+	// still fits in maxStrLen characters and can be parsed back.
+	maxExpArg = 414
+
+	// minExpArg is the argument below which Exp returns zero, as e^-44 < 10^-19
+	minExpArg = -44
+)
+
+var (
+	maxExpArgDecimal = MustFromInt64(maxExpArg, 0)
+	minExpArgDecimal = MustFromInt64(minExpArg, 0)
+)
+
+// Exp returns e raised to the power of d (e^d).
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+//
+// Returns [ErrExpOverflow] if d > 414.
+//
+// Examples:
+//
+//	Exp(0) = 1
+//	Exp(1) = 2.7182818284590452353
+//	Exp(-1) = 0.3678794411714423215
+func (d Decimal) Exp() (Decimal, error) {
+	return DefaultContext().Exp(d)
+}
+
+func (d Decimal) exp(prec uint8) (Decimal, error) {
+	if d.coef.IsZero() {
+		return One, nil
+	}
+
+	if d.Cmp(maxExpArgDecimal) > 0 {
+		return Decimal{}, ErrExpOverflow
+	}
+
+	if d.Cmp(minExpArgDecimal) < 0 {
+		return Zero, nil
+	}
+
+	// estimate the number of integer digits of e^|d|, which is |d| / ln(10) ≈ |d| * 0.4343
+	intPart, _ := d.Abs().Trunc(0).Int64()
+	intDigits := int(intPart)*4343/10000 + 2
+
+	// when d < 0, e^d < 1 so the integer digits of e^|d| are only needed to compute 1 / e^|d| precisely
+	scale := max(int(prec)+mathGuardDigits+intDigits, int(d.prec))
+	one := new(big.Int).Exp(bigTen, big.NewInt(int64(scale)), nil)
+
+	x := d.coef.GetBig()
+	x.Mul(x, new(big.Int).Exp(bigTen, big.NewInt(int64(scale-int(d.prec))), nil))
+
+	if d.neg {
+		x.Neg(x)
+	}
+
+	return fixedToDecimal(expSignedFixed(x, one), scale, prec), nil
+}
+
+// Pow returns d raised to the power of e (d^e), where e can have a fractional part.
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+//
+// If e is an integer, the result is the same as [Decimal.PowToIntPart]. Otherwise it's calculated
+// as e^(e * ln(d)) with enough guard digits so that the truncated result is correct.
+//
+// Returns error if:
+//   - d is zero and e < 0 ([ErrZeroPowNegative])
+//   - d < 0 and e is not an integer ([ErrNegativeFractionalPow])
+//   - e is an integer and |e| > math.MaxInt32 ([ErrExponentTooLarge])
+//   - the result is larger than e^414 ([ErrExpOverflow])
+//
+// Examples:
+//
+//	Pow(2, 0.5) = 1.4142135623730950488
+//	Pow(1.05, 2.5) = 1.1297263219470457217
+//	Pow(4, -1.5) = 0.125
+//	Pow(-8, 2) = 64
+func (d Decimal) Pow(e Decimal) (Decimal, error) {
+	return DefaultContext().Pow(d, e)
+}
+
+func (d Decimal) pow(e Decimal, prec uint8) (Decimal, error) {
+	if e.Trunc(0).Cmp(e) == 0 {
+		return d.powToIntPart(e, prec)
+	}
+
+	if d.coef.IsZero() {
+		if e.neg {
+			return Decimal{}, ErrZeroPowNegative
+		}
+
+		return Zero, nil
+	}
+
+	if d.neg {
+		return Decimal{}, ErrNegativeFractionalPow
+	}
+
+	if d.Cmp(One) == 0 {
+		return One, nil
+	}
+
+	coef := d.coef.GetBig()
+	eCoef := e.coef.GetBig()
+	eDen := new(big.Int).Exp(bigTen, big.NewInt(int64(e.prec)), nil)
+
+	if e.neg {
+		eCoef.Neg(eCoef)
+	}
+
+	// estimate y = e * ln(d) with a few digits to check the range of the result
+	// and to find out how many digits are needed
+	one := new(big.Int).Exp(bigTen, big.NewInt(mathGuardDigits), nil)
+
+	y := lnFixed(coef, d.prec, one)
+	y.Mul(y, eCoef)
+	y.Quo(y, eDen)
+
+	yInt := new(big.Int).Quo(y, one)
+	if yInt.Cmp(big.NewInt(maxExpArg)) >= 0 {
+		return Decimal{}, ErrExpOverflow
+	}
+
+	if yInt.Cmp(big.NewInt(minExpArg)) < 0 {
+		return Zero, nil
+	}
+
+	// the error of ln(d) is multiplied by |e|, so add the number of integer digits of e as well
+	intDigits := int(yInt.Int64())*4343/10000 + 2
+	if intDigits < 2 {
+		intDigits = 2
+	}
+
+	eDigits := len(new(big.Int).Quo(new(big.Int).Abs(eCoef), eDen).Text(10))
+
+	scale := int(prec) + mathGuardDigits + intDigits + eDigits
+	one = new(big.Int).Exp(bigTen, big.NewInt(int64(scale)), nil)
+
+	y = lnFixed(coef, d.prec, one)
+	y.Mul(y, eCoef)
+	y.Quo(y, eDen)
+
+	r := expSignedFixed(y, one)
+
+	// The exact result can have at most prec digits, e.g. 4^0.5 = 2, but the approximation may be
+	// slightly less than that and would be truncated to 1.9999999999999999999.
+	// Round it up to the next unit when the difference is within the calculation error
+	// and the next unit is the exact result.
+	unit := new(big.Int).Exp(bigTen, big.NewInt(int64(scale-int(prec))), nil)
+	diff := new(big.Int).Rem(r, unit)
+	diff.Sub(unit, diff)
+
+	if diff.Cmp(new(big.Int).Exp(bigTen, big.NewInt(int64(scale-int(prec)-mathGuardDigits/2)), nil)) <= 0 {
+		next := new(big.Int).Add(r, diff)
+		next.Quo(next, unit)
+
+		if isExactPow(coef, d.prec, eCoef, eDen, next, prec) {
+			r.Add(r, diff)
+		}
+	}
+
+	return fixedToDecimal(r, scale, prec), nil
+}
+
+// maxExactPowBits is the largest size in bits of the powers computed by isExactPow.
+// Larger powers are too expensive to compute, their result is assumed not to be exact.
+const maxExactPowBits = 1 << 14
+
+// isExactPow reports whether (cCoef / 10^cPrec) = (dCoef / 10^dPrec)^(p / q) exactly, with q > 0,
+// by checking that c^q = d^p with integers only.
+func isExactPow(dCoef *big.Int, dPrec uint8, p, q, cCoef *big.Int, cPrec uint8) bool {
+	gcd := new(big.Int).GCD(nil, nil, new(big.Int).Abs(p), q)
+	p = new(big.Int).Quo(p, gcd)
+	q = new(big.Int).Quo(q, gcd)
+
+	absP := new(big.Int).Abs(p)
+
+	// the sizes of c^q and d^|p|, a digit is less than 4 bits
+	cBits := new(big.Int).Mul(q, big.NewInt(int64(cCoef.BitLen()+4*int(cPrec))))
+	dBits := new(big.Int).Mul(absP, big.NewInt(int64(dCoef.BitLen()+4*int(dPrec))))
+
+	if cBits.Cmp(big.NewInt(maxExactPowBits)) > 0 || dBits.Cmp(big.NewInt(maxExactPowBits)) > 0 {
+		return false
+	}
+
+	// (C / 10^cPrec)^q = (D / 10^dPrec)^p
+	//  - p > 0: C^q * 10^(dPrec*p) = D^p * 10^(cPrec*q)
+	//  - p < 0: C^q * D^|p| = 10^(cPrec*q + dPrec*|p|)
+	qInt, pInt := q.Int64(), absP.Int64()
+
+	lhs := new(big.Int).Exp(cCoef, q, nil)
+	dPow := new(big.Int).Exp(dCoef, absP, nil)
+	cScale := new(big.Int).Exp(bigTen, big.NewInt(int64(cPrec)*qInt), nil)
+	dScale := new(big.Int).Exp(bigTen, big.NewInt(int64(dPrec)*pInt), nil)
+
+	if p.Sign() > 0 {
+		lhs.Mul(lhs, dScale)
+		return lhs.Cmp(dPow.Mul(dPow, cScale)) == 0
+	}
+
+	lhs.Mul(lhs, dPow)
+	return lhs.Cmp(cScale.Mul(cScale, dScale)) == 0
+}
+
+// Ln returns the natural logarithm of d.
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+//
+// Returns [ErrLogNonPositive] if d <= 0.
+//
+// Examples:
+//
+//	Ln(1) = 0
+//	Ln(2) = 0.6931471805599453094
+//	Ln(0.5) = -0.6931471805599453094
+func (d Decimal) Ln() (Decimal, error) {
+	return DefaultContext().Ln(d)
+}
+
+func (d Decimal) ln(prec uint8) (Decimal, error) {
+	if d.neg || d.coef.IsZero() {
+		return Decimal{}, ErrLogNonPositive
+	}
+
+	if d.Cmp(One) == 0 {
+		return Zero, nil
+	}
+
+	scale := int(prec) + mathGuardDigits
+	one := new(big.Int).Exp(bigTen, big.NewInt(int64(scale)), nil)
+
+	return fixedToDecimal(lnFixed(d.coef.GetBig(), d.prec, one), scale, prec), nil
+}
+
+// Log10 returns the base 10 logarithm of d.
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+// The result is exact when d is a power of 10.
+//
+// Returns [ErrLogNonPositive] if d <= 0.
+//
+// Examples:
+//
+//	Log10(1000) = 3
+//	Log10(0.01) = -2
+//	Log10(2) = 0.3010299956639811952
+func (d Decimal) Log10() (Decimal, error) {
+	return DefaultContext().Log10(d)
+}
+
+func (d Decimal) log10(prec uint8) (Decimal, error) {
+	if d.neg || d.coef.IsZero() {
+		return Decimal{}, ErrLogNonPositive
+	}
+
+	coef := d.coef.GetBig()
+
+	// d = 10^k exactly
+	n := len(coef.Text(10)) - 1
+	if coef.Cmp(new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)) == 0 {
+		return NewFromInt64(int64(n)-int64(d.prec), 0)
+	}
+
+	scale := int(prec) + mathGuardDigits
+	one := new(big.Int).Exp(bigTen, big.NewInt(int64(scale)), nil)
+
+	y := lnFixed(coef, d.prec, one)
+	y.Quo(y.Mul(y, one), lnFixed(big.NewInt(10), 0, one))
+
+	return fixedToDecimal(y, scale, prec), nil
+}
+
+// Log2 returns the base 2 logarithm of d.
+// The result will have at most defaultPrec digits after the decimal point, extra digits are truncated.
+// The result is exact when d is a power of 2.
+//
+// Returns [ErrLogNonPositive] if d <= 0.
+//
+// Examples:
+//
+//	Log2(8) = 3
+//	Log2(0.25) = -2
+//	Log2(10) = 3.3219280948873623478
+func (d Decimal) Log2() (Decimal, error) {
+	return DefaultContext().Log2(d)
+}
+
+func (d Decimal) log2(prec uint8) (Decimal, error) {
+	if d.neg || d.coef.IsZero() {
+		return Decimal{}, ErrLogNonPositive
+	}
+
+	coef := d.coef.GetBig()
+
+	// d = coef / 10^prec = 2^k exactly if coef = 2^(k+prec) * 5^prec
+	q, r := new(big.Int).QuoRem(coef, new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(d.prec)), nil), new(big.Int))
+	//nolint:gosec // q > 0, so q.BitLen()-1 >= 0 and it's safe to convert to uint
+	if r.Sign() == 0 && q.TrailingZeroBits() == uint(q.BitLen()-1) {
+		return NewFromInt64(int64(q.BitLen()-1)-int64(d.prec), 0)
+	}
+
+	scale := int(prec) + mathGuardDigits
+	one := new(big.Int).Exp(bigTen, big.NewInt(int64(scale)), nil)
+
+	y := lnFixed(coef, d.prec, one)
+	y.Quo(y.Mul(y, one), ln2Fixed(one))
+
+	return fixedToDecimal(y, scale, prec), nil
+}
+
+// fixedToDecimal converts a fixed-point number v / 10^scale to a decimal, truncating it to prec digits
+func fixedToDecimal(v *big.Int, scale int, prec uint8) Decimal {
+	neg := v.Sign() < 0
+
+	v.Abs(v)
+	v.Quo(v, new(big.Int).Exp(bigTen, big.NewInt(int64(scale-int(prec))), nil))
+
+	return newDecimal(neg, compactBint(v), prec)
+}
+
+// expSignedFixed returns e^(x / one) as a fixed-point number with the same scale as one, where x can be negative.
+func expSignedFixed(x, one *big.Int) *big.Int {
+	if x.Sign() >= 0 {
+		return expFixed(x, one)
+	}
+
+	// e^x = 1 / e^|x|
+	y := expFixed(new(big.Int).Neg(x), one)
+	return y.Quo(new(big.Int).Mul(one, one), y)
+}
+
+// expFixed returns e^(x / one) as a fixed-point number with the same scale as one, where x >= 0.
+func expFixed(x, one *big.Int) *big.Int {
+	// e^x = (e^(x / 2^k))^(2^k), reduce x so that r = x / 2^k < 2^-8 and the Taylor series converges quickly
+	k := max(x.BitLen()-one.BitLen()+8, 0)
+	//nolint:gosec // k >= 0, so it's safe to convert to uint
+	r := new(big.Int).Rsh(x, uint(k))
+
+	// e^r = 1 + r + r^2/2! + r^3/3! + ...
+	sum := new(big.Int).Add(one, r)
+	term := new(big.Int).Set(r)
+
+	for i := int64(2); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, one)
+		term.Quo(term, big.NewInt(i))
+
+		if term.Sign() == 0 {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	for range k {
+		sum.Mul(sum, sum)
+		sum.Quo(sum, one)
+	}
+
+	return sum
+}
+
+// lnFixed returns ln(coef / 10^prec) as a fixed-point number with the same scale as one, where coef > 0.
+func lnFixed(coef *big.Int, prec uint8, one *big.Int) *big.Int {
+	den := new(big.Int).Exp(bigTen, big.NewInt(int64(prec)), nil)
+
+	// ln(d) = ln(m * 2^k) = ln(m) + k * ln(2), choose k so that 0.5 < m < 2
+	k := coef.BitLen() - den.BitLen()
+
+	m := new(big.Int).Mul(coef, one)
+	//nolint:gosec // the shift is always non-negative, so it's safe to convert to uint
+	if k >= 0 {
+		den.Lsh(den, uint(k))
+	} else {
+		m.Lsh(m, uint(-k))
+	}
+
+	m.Quo(m, den)
+
+	// ln(m) = 2 * atanh((m - 1) / (m + 1))
+	u := new(big.Int).Sub(m, one)
+	u.Mul(u, one)
+	u.Quo(u, m.Add(m, one))
+
+	y := atanhFixed(u, one)
+	y.Lsh(y, 1)
+
+	if k != 0 {
+		y.Add(y, new(big.Int).Mul(ln2Fixed(one), big.NewInt(int64(k))))
+	}
+
+	return y
+}
+
+// ln2Fixed returns ln(2) = 2 * atanh(1/3) as a fixed-point number with the same scale as one
+func ln2Fixed(one *big.Int) *big.Int {
+	y := atanhFixed(new(big.Int).Quo(one, big.NewInt(3)), one)
+	return y.Lsh(y, 1)
+}
+
+// atanhFixed returns atanh(u / one) as a fixed-point number with the same scale as one, where |u / one| < 1.
+func atanhFixed(u, one *big.Int) *big.Int {
+	// atanh(u) = u + u^3/3 + u^5/5 + ...
+	u2 := new(big.Int).Mul(u, u)
+	u2.Quo(u2, one)
+
+	sum := new(big.Int).Set(u)
+	pow := new(big.Int).Set(u)
+	term := new(big.Int)
+
+	for i := int64(3); ; i += 2 {
+		pow.Mul(pow, u2)
+		pow.Quo(pow, one)
+
+		term.Quo(pow, big.NewInt(i))
+		if term.Sign() == 0 {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	return sum
+}
@@ -0,0 +1,247 @@
+package udecimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExp(t *testing.T) {
+	testcases := []struct {
+		a       string
+		want    string
+		wantErr error
+	}{
+		{"0", "1", nil},
+		{"1", "2.7182818284590452353", nil},
+		{"-1", "0.3678794411714423215", nil},
+		{"2", "7.3890560989306502272", nil},
+		{"0.5", "1.6487212707001281468", nil},
+		{"-0.5", "0.6065306597126334236", nil},
+		{"10", "22026.4657948067165169579", nil},
+		{"-10.123", "0.0000401455081717788", nil},
+		{"0.0000000000000000001", "1.0000000000000000001", nil},
+		{"-0.0000000000000000001", "0.9999999999999999999", nil},
+		{"1.2345678901234567891", "3.4368930843460080048", nil},
+		{"-43.9", "0", nil},
+		{"-43.7", "0.0000000000000000001", nil},
+		{"45.5", "57596875768879535865.2685187821548774841", nil},
+		{"100", "26881171418161354484126255515800135873611118.7737419224151916086", nil},
+		{"-100", "0", nil},
+		{"413.9999999999999999999", "627936181854688741453743715156610740562910283962042861801960806210224581376530390073394776951410433844645417242760996583566613162904341051513027528339841189419813683606696028893739.4658517725139542251", nil},
+		{"414", "627936181854688741516537333342079614711424336386977379565625209212521036049785929134177945724589871523857998757232486196392857007609388388202232333342910994292705695192413082428075.095307805878463939", nil},
+		{"-123456789012345678901234567890", "0", nil},
+		{"414.0000000000000000001", "", ErrExpOverflow},
+		{"1000", "", ErrExpOverflow},
+		{"123456789012345678901234567890", "", ErrExpOverflow},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.a, func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			b, err := a.Exp()
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+		})
+	}
+}
+
+func TestLn(t *testing.T) {
+	testcases := []struct {
+		a       string
+		want    string
+		wantErr error
+	}{
+		{"1", "0", nil},
+		{"2", "0.6931471805599453094", nil},
+		{"0.5", "-0.6931471805599453094", nil},
+		{"10", "2.302585092994045684", nil},
+		{"0.0000000000000000001", "-43.7491167668868679963", nil},
+		{"3.1415926535897932384", "1.1447298858494001741", nil},
+		{"1234567890123456789012345678901234567890", "90.0115396490834342377", nil},
+		{"12345678901234567890123456789012345678901234567890.123", "113.0373905790238910779", nil},
+		{"0.9999999999999999999", "-0.0000000000000000001", nil},
+		{"1.0000000000000000001", "0", nil},
+		{"100", "4.605170185988091368", nil},
+		{"1000000", "13.8155105579642741041", nil},
+		{"0", "", ErrLogNonPositive},
+		{"-1", "", ErrLogNonPositive},
+		{"-0.5", "", ErrLogNonPositive},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.a, func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			b, err := a.Ln()
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+		})
+	}
+}
+
+func TestLog10(t *testing.T) {
+	testcases := []struct {
+		a       string
+		want    string
+		wantErr error
+	}{
+		{"1", "0", nil},
+		{"2", "0.3010299956639811952", nil},
+		{"0.5", "-0.3010299956639811952", nil},
+		{"10", "1", nil},
+		{"0.0000000000000000001", "-19", nil},
+		{"3.1415926535897932384", "0.4971498726941338543", nil},
+		{"1234567890123456789012345678901234567890", "39.0915149772126998957", nil},
+		{"12345678901234567890123456789012345678901234567890.123", "49.0915149772126998957", nil},
+		{"0.9999999999999999999", "0", nil},
+		{"1.0000000000000000001", "0", nil},
+		{"100", "2", nil},
+		{"1000000", "6", nil},
+		{"0.001", "-3", nil},
+		{"0.002", "-2.6989700043360188047", nil},
+		{"1000.0001", "3.0000000434294460188", nil},
+		{"1000.000", "3", nil},
+		{"0", "", ErrLogNonPositive},
+		{"-10", "", ErrLogNonPositive},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.a, func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			b, err := a.Log10()
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+		})
+	}
+}
+
+func TestLog2(t *testing.T) {
+	testcases := []struct {
+		a       string
+		want    string
+		wantErr error
+	}{
+		{"1", "0", nil},
+		{"2", "1", nil},
+		{"0.5", "-1", nil},
+		{"10", "3.3219280948873623478", nil},
+		{"0.0000000000000000001", "-63.1166338028598846095", nil},
+		{"3.1415926535897932384", "1.651496129472318798", nil},
+		{"1234567890123456789012345678901234567890", "129.8592018745129761091", nil},
+		{"12345678901234567890123456789012345678901234567890.123", "163.0784828233865995878", nil},
+		{"0.9999999999999999999", "-0.0000000000000000001", nil},
+		{"1.0000000000000000001", "0.0000000000000000001", nil},
+		{"100", "6.6438561897747246957", nil},
+		{"1000000", "19.9315685693241740872", nil},
+		{"8", "3", nil},
+		{"0.25", "-2", nil},
+		{"0.0009765625", "-10", nil},
+		{"1024.000", "10", nil},
+		{"3", "1.5849625007211561814", nil},
+		{"0.3", "-1.7369655941662061664", nil},
+		{"340282366920938463463374607431768211456", "128", nil},
+		{"0", "", ErrLogNonPositive},
+		{"-8", "", ErrLogNonPositive},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.a, func(t *testing.T) {
+			a := MustParse(tc.a)
+
+			b, err := a.Log2()
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+		})
+	}
+}
+
+func TestPow(t *testing.T) {
+	testcases := []struct {
+		a, e    string
+		want    string
+		wantErr error
+	}{
+		{"2", "0.5", "1.4142135623730950488", nil},
+		{"1.05", "2.5", "1.1297263219470457217", nil},
+		{"4", "-1.5", "0.125", nil},
+		{"2.5", "2.6", "10.8303881742991623887", nil},
+		{"1.0001", "36500.5", "38.4695688653449470932", nil},
+		{"0.5", "0.5", "0.7071067811865475244", nil},
+		{"1.0000001", "1000000000.5", "26881038356701055136223322520735843418287670.4854123820834493497", nil},
+		{"123456789012345678901234567890", "0.3", "533893327.5049719099190148427", nil},
+		{"4", "0.5", "2", nil},
+		{"9", "0.5", "3", nil},
+		{"8", "0.3333333333333333333", "1.9999999999999999998", nil},
+		{"16", "0.75", "8", nil},
+		{"1.21", "0.5", "1.1", nil},
+		{"1024", "0.1", "2", nil},
+		{"1.0000000000000000001", "0.5", "1", nil},
+		{"10", "-18.5", "0.0000000000000000003", nil},
+		{"0.0001", "2.5", "0.0000000001", nil},
+		{"3.375", "0.3333333333333333333", "1.4999999999999999999", nil},
+		{"1.1", "0.0000000000000000001", "1", nil},
+		{"2", "10", "1024", nil},
+		{"-8", "3", "-512", nil},
+		{"2", "-2", "0.25", nil},
+		{"-2", "-3", "-0.125", nil},
+		{"1.5", "2.0", "2.25", nil},
+		{"0", "0", "1", nil},
+		{"0", "0.5", "0", nil},
+		{"1", "123.456", "1", nil},
+		{"10", "-500.5", "0", nil},
+		{"1.0027397260273972602", "365", "2.7145674820218742301", nil},
+		{"0", "-1", "", ErrZeroPowNegative},
+		{"0", "-0.5", "", ErrZeroPowNegative},
+		{"-8", "0.5", "", ErrNegativeFractionalPow},
+		{"-1", "0.3333333333333333333", "", ErrNegativeFractionalPow},
+		{"2", "3000000000", "", ErrExponentTooLarge},
+		{"10", "180.5", "", ErrExpOverflow},
+		{"123456789012345678901234567890", "100.5", "", ErrExpOverflow},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.a+"^"+tc.e, func(t *testing.T) {
+			a := MustParse(tc.a)
+			e := MustParse(tc.e)
+
+			b, err := a.Pow(e)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, b.String())
+
+			// integer exponents give the same result as PowToIntPart
+			if e.Trunc(0).Equal(e) {
+				c, err := a.PowToIntPart(e)
+				require.NoError(t, err)
+				require.Equal(t, c, b)
+			}
+		})
+	}
+}
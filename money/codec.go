@@ -0,0 +1,230 @@
+package money
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+)
+
+var (
+	_ fmt.Stringer               = (*Money)(nil)
+	_ sql.Scanner                = (*Money)(nil)
+	_ driver.Valuer              = (*Money)(nil)
+	_ encoding.TextMarshaler     = (*Money)(nil)
+	_ encoding.TextUnmarshaler   = (*Money)(nil)
+	_ encoding.BinaryMarshaler   = (*Money)(nil)
+	_ encoding.BinaryUnmarshaler = (*Money)(nil)
+	_ json.Marshaler             = (*Money)(nil)
+	_ json.Unmarshaler           = (*Money)(nil)
+)
+
+// String returns the amount with at least the minor units of the currency followed by the currency code.
+// The zero value (no currency) returns the amount only.
+//
+// Example:
+//
+//	12.3 USD -> "12.30 USD"
+//	12.345 USD -> "12.345 USD"
+//	1000 JPY -> "1000 JPY"
+func (m Money) String() string {
+	b, _ := m.AppendText(nil)
+	return string(b)
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The format is the same as [Money.String].
+func (m Money) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// AppendText implements the [encoding.TextAppender] interface.
+func (m Money) AppendText(b []byte) ([]byte, error) {
+	b = append(b, m.amount.StringFixed(m.currency.minor)...)
+	if m.currency.IsZero() {
+		return b, nil
+	}
+
+	b = append(b, ' ')
+	return append(b, m.currency.code...), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the format produced by [Money.MarshalText], e.g. "12.34 USD".
+// An amount without currency is decoded to Money without currency, like the zero value.
+func (m *Money) UnmarshalText(data []byte) error {
+	amount, code := data, []byte(nil)
+	if i := bytes.IndexByte(data, ' '); i >= 0 {
+		amount, code = data[:i], data[i+1:]
+		if len(code) == 0 {
+			return fmt.Errorf("error unmarshaling to Money: %w", ErrInvalidFormat)
+		}
+	}
+
+	currency, err := parseCurrencyOrZero(string(code))
+	if err != nil {
+		return fmt.Errorf("error unmarshaling to Money: %w", err)
+	}
+
+	var d udecimal.Decimal
+	if err := d.UnmarshalText(amount); err != nil {
+		return fmt.Errorf("error unmarshaling to Money: %w", err)
+	}
+
+	*m = Money{amount: d, currency: currency}
+	return nil
+}
+
+// moneyJSON is the JSON representation of Money
+type moneyJSON struct {
+	Amount   udecimal.Decimal `json:"amount"`
+	Currency string           `json:"currency"`
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+//
+//	12.3 USD -> {"amount":"12.30","currency":"USD"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 64)
+	b = append(b, `{"amount":"`...)
+	b = append(b, m.amount.StringFixed(m.currency.minor)...)
+	b = append(b, `","currency":"`...)
+	b = append(b, m.currency.code...)
+	b = append(b, `"}`...)
+
+	return b, nil
+}
+
+// nullValue represents the JSON null value.
+var nullValue = []byte("null")
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	// null value.
+	if bytes.Equal(data, nullValue) {
+		return nil
+	}
+
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("error unmarshaling to Money: %w", err)
+	}
+
+	currency, err := parseCurrencyOrZero(v.Currency)
+	if err != nil {
+		return fmt.Errorf("error unmarshaling to Money: %w", err)
+	}
+
+	*m = Money{amount: v.Amount, currency: currency}
+	return nil
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler] interface with custom binary format.
+//
+//	Binary format: [code length] [code] [amount]
+//
+//	 code length is 3 (or 0 for the zero value without currency),
+//	 amount is encoded with [udecimal.Decimal.MarshalBinary].
+//
+//	 example: 12.34 USD
+//	 1st byte: 0x03 (code length = 3)
+//	 2nd-4th bytes: "USD"
+//	 5th-15th bytes: 12.34 in udecimal binary format
+func (m Money) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(nil)
+}
+
+// AppendBinary implements [encoding.BinaryAppender] interface.
+func (m Money) AppendBinary(b []byte) ([]byte, error) {
+	//nolint:gosec // currency code is either empty or 3 characters
+	b = append(b, byte(len(m.currency.code)))
+	b = append(b, m.currency.code...)
+
+	return m.amount.AppendBinary(b)
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler] interface.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) < 1 {
+		return udecimal.ErrInvalidBinaryData
+	}
+
+	n := int(data[0])
+	if (n != 0 && n != 3) || len(data) < 1+n {
+		return udecimal.ErrInvalidBinaryData
+	}
+
+	currency, err := parseCurrencyOrZero(string(data[1 : 1+n]))
+	if err != nil {
+		return err
+	}
+
+	var d udecimal.Decimal
+	if err := d.UnmarshalBinary(data[1+n:]); err != nil {
+		return err
+	}
+
+	*m = Money{amount: d, currency: currency}
+	return nil
+}
+
+// Scan implements [sql.Scanner] interface.
+// It accepts the format produced by [Money.Value], e.g. "12.34 USD".
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return m.UnmarshalText(v)
+	case string:
+		return m.UnmarshalText([]byte(v))
+	case nil:
+		return fmt.Errorf("can't scan nil to Money")
+	default:
+		return fmt.Errorf("can't scan %T to Money: %T is not supported", src, src)
+	}
+}
+
+// Value implements [driver.Valuer] interface.
+// The value is the same as [Money.String], e.g. "12.34 USD".
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// NullMoney is a nullable Money.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+// Scan implements [sql.Scanner] interface.
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (m *NullMoney) Scan(src any) error {
+	if src == nil {
+		m.Money, m.Valid = Money{}, false
+		return nil
+	}
+
+	err := m.Money.Scan(src)
+	m.Valid = err == nil
+
+	return err
+}
+
+// Value implements the [driver.Valuer] interface.
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (m NullMoney) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+
+	return m.Money.String(), nil
+}
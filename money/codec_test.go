@@ -0,0 +1,249 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalText(t *testing.T) {
+	testcases := []struct {
+		in   Money
+		want string
+	}{
+		{MustParse("12.34", "USD"), "12.34 USD"},
+		{MustParse("12.3", "USD"), "12.30 USD"},
+		{MustParse("-12.345", "USD"), "-12.345 USD"},
+		{MustParse("1000", "JPY"), "1000 JPY"},
+		{MustParse("-0.5", "JPY"), "-0.5 JPY"},
+		{MustParse("1", "BHD"), "1.000 BHD"},
+		{MustParse("123456789012345678901234567890123456789.1", "EUR"), "123456789012345678901234567890123456789.10 EUR"},
+		{Money{}, "0"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.want, func(t *testing.T) {
+			b, err := tc.in.MarshalText()
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(b))
+
+			b, err = tc.in.AppendText([]byte("price: "))
+			require.NoError(t, err)
+			require.Equal(t, "price: "+tc.want, string(b))
+
+			// unmarshal back
+			var m Money
+			require.NoError(t, m.UnmarshalText([]byte(tc.want)))
+			require.True(t, tc.in.Equal(m))
+		})
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	testcases := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{"12.34 usd", "12.34 USD", nil},
+		{"12.34", "12.34", nil},
+		{"12.34 ", "", ErrInvalidFormat},
+		{"12.34 ABC", "", ErrUnknownCurrency},
+		{"12.34 USD ", "", ErrUnknownCurrency},
+		{" USD", "", udecimal.ErrEmptyString},
+		{"", "", udecimal.ErrEmptyString},
+		{"1.2.3 USD", "", udecimal.ErrInvalidFormat},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			var m Money
+
+			err := m.UnmarshalText([]byte(tc.in))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, m.String())
+		})
+	}
+}
+
+type Order struct {
+	Price Money `json:"price"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	testcases := []struct {
+		in   Money
+		want string
+	}{
+		{MustParse("12.34", "USD"), `{"price":{"amount":"12.34","currency":"USD"}}`},
+		{MustParse("12.3", "USD"), `{"price":{"amount":"12.30","currency":"USD"}}`},
+		{MustParse("-1000", "JPY"), `{"price":{"amount":"-1000","currency":"JPY"}}`},
+		{MustParse("0.0001", "BHD"), `{"price":{"amount":"0.0001","currency":"BHD"}}`},
+		{MustParse("-12345678901234567890123456789.1234567890123456789", "EUR"), `{"price":{"amount":"-12345678901234567890123456789.1234567890123456789","currency":"EUR"}}`},
+		{Money{}, `{"price":{"amount":"0","currency":""}}`},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.want, func(t *testing.T) {
+			b, err := json.Marshal(Order{Price: tc.in})
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(b))
+
+			// unmarshal back
+			var o Order
+			require.NoError(t, json.Unmarshal(b, &o))
+			require.True(t, tc.in.Equal(o.Price))
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	testcases := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{`{"price":{"amount":"12.34","currency":"usd"}}`, "12.34 USD", nil},
+		{`{"price":{"amount":12.34,"currency":"USD"}}`, "12.34 USD", nil},
+		{`{"price":{"currency":"USD","amount":"1"}}`, "1.00 USD", nil},
+		{`{"price":null}`, "0", nil},
+		{`{"price":{"amount":"12.34","currency":"ABC"}}`, "", ErrUnknownCurrency},
+		{`{"price":{"amount":"1.2.3","currency":"USD"}}`, "", udecimal.ErrInvalidFormat},
+		{`{"price":"12.34 USD"}`, "", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			var o Order
+
+			err := json.Unmarshal([]byte(tc.in), &o)
+			if tc.want == "" {
+				require.Error(t, err)
+
+				if tc.wantErr != nil {
+					require.ErrorIs(t, err, tc.wantErr)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, o.Price.String())
+		})
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	testcases := []Money{
+		MustParse("12.34", "USD"),
+		MustParse("-12.345", "USD"),
+		MustParse("1000", "JPY"),
+		MustParse("0", "BHD"),
+		MustParse("-12345678901234567890123456789.1234567890123456789", "EUR"),
+		{},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.String(), func(t *testing.T) {
+			b, err := tc.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, byte(len(tc.Currency().Code())), b[0])
+			require.Equal(t, tc.Currency().Code(), string(b[1:1+b[0]]))
+
+			var m Money
+			require.NoError(t, m.UnmarshalBinary(b))
+			require.Equal(t, tc, m)
+
+			// append to existing buffer
+			b, err = tc.AppendBinary([]byte{1, 2, 3})
+			require.NoError(t, err)
+
+			require.NoError(t, m.UnmarshalBinary(b[3:]))
+			require.Equal(t, tc, m)
+		})
+	}
+}
+
+func TestInvalidUnmarshalBinary(t *testing.T) {
+	valid, err := MustParse("12.34", "USD").MarshalBinary()
+	require.NoError(t, err)
+
+	testcases := []struct {
+		in      []byte
+		wantErr error
+	}{
+		{nil, udecimal.ErrInvalidBinaryData},
+		{[]byte{3, 'U', 'S'}, udecimal.ErrInvalidBinaryData},
+		{[]byte{2, 'U', 'S'}, udecimal.ErrInvalidBinaryData},
+		{append([]byte{3, 'A', 'B', 'C'}, valid[4:]...), ErrUnknownCurrency},
+		{valid[:len(valid)-1], udecimal.ErrInvalidBinaryData},
+		{valid[:4], udecimal.ErrInvalidBinaryData},
+	}
+
+	for _, tc := range testcases {
+		var m Money
+		require.ErrorIs(t, m.UnmarshalBinary(tc.in), tc.wantErr)
+	}
+}
+
+func TestScan(t *testing.T) {
+	testcases := []struct {
+		in      any
+		want    string
+		wantErr bool
+	}{
+		{"12.34 USD", "12.34 USD", false},
+		{[]byte("1000 JPY"), "1000 JPY", false},
+		{"12.34 ABC", "", true},
+		{nil, "", true},
+		{int64(1), "", true},
+		{1.5, "", true},
+	}
+
+	for _, tc := range testcases {
+		var m Money
+
+		err := m.Scan(tc.in)
+		if tc.wantErr {
+			require.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.want, m.String())
+
+		v, err := m.Value()
+		require.NoError(t, err)
+		require.Equal(t, tc.want, v)
+	}
+}
+
+func TestNullScan(t *testing.T) {
+	var m NullMoney
+
+	require.NoError(t, m.Scan("12.34 USD"))
+	require.True(t, m.Valid)
+	require.Equal(t, "12.34 USD", m.Money.String())
+
+	v, err := m.Value()
+	require.NoError(t, err)
+	require.Equal(t, "12.34 USD", v)
+
+	require.NoError(t, m.Scan(nil))
+	require.False(t, m.Valid)
+	require.Equal(t, Money{}, m.Money)
+
+	v, err = m.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	require.Error(t, m.Scan("12.34 ABC"))
+	require.False(t, m.Valid)
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	testcases := []struct {
+		code    string
+		want    string
+		minor   uint8
+		wantErr error
+	}{
+		{"USD", "USD", 2, nil},
+		{"usd", "USD", 2, nil},
+		{"Eur", "EUR", 2, nil},
+		{"JPY", "JPY", 0, nil},
+		{"KRW", "KRW", 0, nil},
+		{"BHD", "BHD", 3, nil},
+		{"KWD", "KWD", 3, nil},
+		{"CLF", "CLF", 4, nil},
+		{"UYW", "UYW", 4, nil},
+		{"", "", 0, ErrUnknownCurrency},
+		{"US", "", 0, ErrUnknownCurrency},
+		{"USDT", "", 0, ErrUnknownCurrency},
+		{"XYZ", "", 0, ErrUnknownCurrency},
+		{"XAU", "", 0, ErrUnknownCurrency},
+		{"U$D", "", 0, ErrUnknownCurrency},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.code, func(t *testing.T) {
+			c, err := ParseCurrency(tc.code)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.Panics(t, func() { MustParseCurrency(tc.code) })
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.Code())
+			require.Equal(t, tc.want, c.String())
+			require.Equal(t, tc.minor, c.MinorUnits())
+			require.False(t, c.IsZero())
+			require.Equal(t, c, MustParseCurrency(tc.code))
+		})
+	}
+}
+
+func TestCurrencyTable(t *testing.T) {
+	require.True(t, Currency{}.IsZero())
+
+	for code, c := range currencies {
+		require.Len(t, code, 3)
+		require.Equal(t, code, c.code)
+		require.LessOrEqual(t, int(c.minor), len(pow10)-1)
+
+		for i := 0; i < 3; i++ {
+			require.True(t, code[i] >= 'A' && code[i] <= 'Z', code)
+		}
+	}
+}
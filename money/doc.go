@@ -0,0 +1,19 @@
+// Package money provides a Money type, which pairs a [udecimal.Decimal] amount with an ISO 4217 currency.
+//
+// Amounts in different currencies can't be mixed by mistake: Add, Sub and Cmp return [ErrCurrencyMismatch]
+// instead of silently adding USD to JPY. Each [Currency] knows its number of minor units
+// (e.g. 2 for USD, 0 for JPY and 3 for BHD), which is used for rounding ([Money.Round]),
+// allocation without losing minor units ([Money.Allocate], [Money.Split]) and formatting.
+//
+// Like udecimal, this package doesn't perform implicit rounding. Results keep all their digits
+// until [Money.Round] is called explicitly.
+//
+// # Codec
+//
+// Money supports the same encoding and decoding mechanisms as [udecimal.Decimal]:
+//
+//   - Marshal/UnmarshalJSON: {"amount":"12.34","currency":"USD"}
+//   - Marshal/UnmarshalText: "12.34 USD"
+//   - Marshal/UnmarshalBinary: the currency code followed by the udecimal binary format
+//   - SQL: "12.34 USD" through sql.Scanner and driver.Valuer, see also [NullMoney]
+package money
@@ -0,0 +1,59 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+)
+
+func ExampleParse() {
+	fmt.Println(Parse("12.3", "USD"))
+	fmt.Println(Parse("1000", "jpy"))
+	fmt.Println(Parse("1", "ABC"))
+	// Output:
+	// 12.30 USD <nil>
+	// 1000 JPY <nil>
+	// 0 unknown currency: 'ABC'
+}
+
+func ExampleMoney_Add() {
+	fmt.Println(MustParse("12.34", "USD").Add(MustParse("0.66", "USD")))
+	fmt.Println(MustParse("12.34", "USD").Add(MustParse("100", "JPY")))
+	// Output:
+	// 13.00 USD <nil>
+	// 0 currency mismatch: USD and JPY
+}
+
+func ExampleMoney_Round() {
+	price := MustParse("19.99", "USD")
+	tax := price.Mul(udecimal.MustParse("0.085"))
+
+	fmt.Println(tax)
+	fmt.Println(tax.Round(udecimal.RoundHalfEven))
+	fmt.Println(MustParse("12.5", "JPY").Round(udecimal.RoundHalfUp))
+	// Output:
+	// 1.69915 USD
+	// 1.70 USD <nil>
+	// 13 JPY <nil>
+}
+
+func ExampleMoney_Split() {
+	fmt.Println(MustParse("100", "USD").Split(3))
+	fmt.Println(MustParse("100", "JPY").Split(3))
+	// Output:
+	// [33.34 USD 33.33 USD 33.33 USD] <nil>
+	// [34 JPY 33 JPY 33 JPY] <nil>
+}
+
+func ExampleMoney_MarshalJSON() {
+	b, _ := json.Marshal(MustParse("12.3", "USD"))
+	fmt.Println(string(b))
+
+	var m Money
+	_ = json.Unmarshal([]byte(`{"amount":"1.5","currency":"BHD"}`), &m)
+	fmt.Println(m)
+	// Output:
+	// {"amount":"12.30","currency":"USD"}
+	// 1.500 BHD
+}
@@ -0,0 +1,262 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+)
+
+var (
+	// ErrUnknownCurrency is returned when the currency code is not in the ISO 4217 table
+	ErrUnknownCurrency = fmt.Errorf("unknown currency")
+
+	// ErrCurrencyMismatch is returned when adding, subtracting or comparing amounts in different currencies
+	ErrCurrencyMismatch = fmt.Errorf("currency mismatch")
+
+	// ErrInvalidFormat is returned when the text representation of Money is not in the "<amount> <currency>" format
+	ErrInvalidFormat = fmt.Errorf("invalid money format. Must be '<amount> <currency>'")
+)
+
+// pow10[n] = 10^n, the largest number of minor units in the ISO 4217 table is 4
+var pow10 = [5]uint64{1, 10, 100, 1000, 10000}
+
+// Money represents an amount of money in a specific currency.
+//
+// Like [udecimal.Decimal], Money is immutable and doesn't perform implicit rounding. The amount keeps all its digits
+// until [Money.Round] is called explicitly, so intermediate results (e.g. interest, FX conversion) don't lose precision.
+//
+// Operations combining two Money values (Add, Sub, Cmp) return [ErrCurrencyMismatch] if the currencies are different.
+//
+// The zero value is an amount of 0 without currency.
+type Money struct {
+	amount   udecimal.Decimal
+	currency Currency
+}
+
+// New returns an amount of money in the given currency.
+// The amount is kept as is, use [Money.Round] to round it to the minor units of the currency.
+func New(amount udecimal.Decimal, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+// NewFromMinorUnits returns an amount of money from a number of minor units of the currency,
+// e.g. 1234 minor units of USD is 12.34 USD and 1234 minor units of JPY is 1234 JPY.
+func NewFromMinorUnits(units int64, currency Currency) Money {
+	// minor units are at most 4, so this never fails
+	return Money{amount: udecimal.MustFromInt64(units, currency.minor), currency: currency}
+}
+
+// Parse parses an amount and an ISO 4217 currency code into Money.
+//
+// Example:
+//
+//	Parse("12.34", "USD") = 12.34 USD
+//	Parse("1000", "jpy") = 1000 JPY
+func Parse(amount, code string) (Money, error) {
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+
+	d, err := udecimal.Parse(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: d, currency: currency}, nil
+}
+
+// MustParse similars to Parse, but panics instead of returning error
+func MustParse(amount, code string) Money {
+	m, err := Parse(amount, code)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Amount returns the amount of m.
+func (m Money) Amount() udecimal.Decimal {
+	return m.amount
+}
+
+// Currency returns the currency of m.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// checkCurrency returns ErrCurrencyMismatch if m and n have different currencies
+func (m Money) checkCurrency(n Money) error {
+	if m.currency != n.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency.code, n.currency.code)
+	}
+
+	return nil
+}
+
+// Add returns m + n.
+// Returns [ErrCurrencyMismatch] if m and n have different currencies.
+func (m Money) Add(n Money) (Money, error) {
+	if err := m.checkCurrency(n); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: m.amount.Add(n.amount), currency: m.currency}, nil
+}
+
+// Sub returns m - n.
+// Returns [ErrCurrencyMismatch] if m and n have different currencies.
+func (m Money) Sub(n Money) (Money, error) {
+	if err := m.checkCurrency(n); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: m.amount.Sub(n.amount), currency: m.currency}, nil
+}
+
+// Mul returns m * d, e.g. a price multiplied by a quantity.
+// The result isn't rounded, see [Money.Round].
+func (m Money) Mul(d udecimal.Decimal) Money {
+	return Money{amount: m.amount.Mul(d), currency: m.currency}
+}
+
+// Div returns m / d.
+// The result isn't rounded to the minor units of the currency, see [Money.Round].
+// To split an amount without losing any minor unit, use [Money.Split] or [Money.Allocate] instead.
+//
+// Returns [udecimal.ErrDivideByZero] if d is zero.
+func (m Money) Div(d udecimal.Decimal) (Money, error) {
+	q, err := m.amount.Div(d)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: q, currency: m.currency}, nil
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Abs returns |m|.
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency}
+}
+
+// Cmp compares the amounts of m and n and returns:
+//
+//	-1 if m < n
+//	 0 if m == n
+//	+1 if m > n
+//
+// Returns [ErrCurrencyMismatch] if m and n have different currencies.
+func (m Money) Cmp(n Money) (int, error) {
+	if err := m.checkCurrency(n); err != nil {
+		return 0, err
+	}
+
+	return m.amount.Cmp(n.amount), nil
+}
+
+// Equal returns true if m and n have the same currency and the same amount.
+func (m Money) Equal(n Money) bool {
+	return m.currency == n.currency && m.amount.Equal(n.amount)
+}
+
+// Sign returns:
+//
+//	-1 if m < 0
+//	 0 if m == 0
+//	+1 if m > 0
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+// IsZero returns true if the amount of m is zero.
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// IsNeg returns true if the amount of m is negative.
+func (m Money) IsNeg() bool {
+	return m.amount.IsNeg()
+}
+
+// IsPos returns true if the amount of m is positive.
+func (m Money) IsPos() bool {
+	return m.amount.IsPos()
+}
+
+// Round rounds the amount of m to the minor units of its currency using the given rounding mode.
+//
+// Returns error if:
+//   - mode is not a valid [udecimal.RoundingMode] ([udecimal.ErrInvalidRoundingMode])
+//   - mode is [udecimal.RoundUnnecessary] and the amount has more digits than the minor units ([udecimal.ErrRoundingNecessary])
+//
+// Example:
+//
+//	Round(12.345 USD, RoundHalfEven) = 12.34 USD
+//	Round(12.5 JPY, RoundHalfUp) = 13 JPY
+//	Round(1.23456 BHD, RoundDown) = 1.234 BHD
+func (m Money) Round(mode udecimal.RoundingMode) (Money, error) {
+	d, err := m.amount.Round(m.currency.minor, mode)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: d, currency: m.currency}, nil
+}
+
+// IsRounded returns true if the amount of m can be represented exactly with the minor units of its currency,
+// e.g. 12.34 USD and 12.340 USD are rounded but 12.345 USD and 0.5 JPY are not.
+func (m Money) IsRounded() bool {
+	return m.amount.Prec() <= int(m.currency.minor) || m.amount.Trunc(m.currency.minor).Equal(m.amount)
+}
+
+// MinorUnits returns the amount of m as a number of minor units of its currency, e.g. 12.34 USD = 1234.
+// Returns [udecimal.ErrRoundingNecessary] if the amount has more digits than the minor units (see [Money.Round]),
+// or [udecimal.ErrIntPartOverflow] if the result doesn't fit in int64.
+func (m Money) MinorUnits() (int64, error) {
+	if !m.IsRounded() {
+		return 0, udecimal.ErrRoundingNecessary
+	}
+
+	return m.amount.Mul(udecimal.MustFromUint64(pow10[m.currency.minor], 0)).Int64()
+}
+
+// Allocate splits m into len(ratios) parts proportionally to ratios, rounded to the minor units of the currency.
+// The parts always sum up exactly to m. See [udecimal.Decimal.Allocate] for more details.
+//
+// Returns error if m has more digits than the minor units of its currency or ratios are invalid.
+func (m Money) Allocate(ratios ...udecimal.Decimal) ([]Money, error) {
+	parts, err := m.amount.Allocate(m.currency.minor, ratios...)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.withAmounts(parts), nil
+}
+
+// Split splits m into n parts as equal as possible, rounded to the minor units of the currency.
+// The parts always sum up exactly to m. See [udecimal.Decimal.Split] for more details.
+//
+// Returns error if m has more digits than the minor units of its currency or n < 1.
+func (m Money) Split(n int) ([]Money, error) {
+	parts, err := m.amount.Split(n, m.currency.minor)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.withAmounts(parts), nil
+}
+
+func (m Money) withAmounts(amounts []udecimal.Decimal) []Money {
+	res := make([]Money, len(amounts))
+	for i, a := range amounts {
+		res[i] = Money{amount: a, currency: m.currency}
+	}
+
+	return res
+}
@@ -0,0 +1,230 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testcases := []struct {
+		amount, code string
+		want         string
+		wantErr      error
+	}{
+		{"12.34", "USD", "12.34 USD", nil},
+		{"12.3", "usd", "12.30 USD", nil},
+		{"-12.345", "USD", "-12.345 USD", nil},
+		{"1000", "JPY", "1000 JPY", nil},
+		{"1.5", "BHD", "1.500 BHD", nil},
+		{"0", "EUR", "0.00 EUR", nil},
+		{"123456789012345678901234567890123456789.12", "EUR", "123456789012345678901234567890123456789.12 EUR", nil},
+		{"12.34", "ABC", "", ErrUnknownCurrency},
+		{"12.34", "", "", ErrUnknownCurrency},
+		{"1e3", "USD", "1000.00 USD", nil},
+		{"1.2.3", "USD", "", udecimal.ErrInvalidFormat},
+		{"", "USD", "", udecimal.ErrEmptyString},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s", tc.amount, tc.code), func(t *testing.T) {
+			m, err := Parse(tc.amount, tc.code)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.Panics(t, func() { MustParse(tc.amount, tc.code) })
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, m.String())
+			require.Equal(t, udecimal.MustParse(tc.amount), m.Amount())
+			require.Equal(t, MustParseCurrency(tc.code), m.Currency())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	usd := MustParseCurrency("USD")
+
+	m := New(udecimal.MustParse("1.2345"), usd)
+	require.Equal(t, "1.2345 USD", m.String())
+	require.Equal(t, usd, m.Currency())
+
+	require.Equal(t, "12.34 USD", NewFromMinorUnits(1234, usd).String())
+	require.Equal(t, "-0.05 USD", NewFromMinorUnits(-5, usd).String())
+	require.Equal(t, "1234 JPY", NewFromMinorUnits(1234, MustParseCurrency("JPY")).String())
+	require.Equal(t, "1.234 BHD", NewFromMinorUnits(1234, MustParseCurrency("BHD")).String())
+	require.Equal(t, "0.1234 CLF", NewFromMinorUnits(1234, MustParseCurrency("CLF")).String())
+
+	var zero Money
+	require.Equal(t, "0", zero.String())
+	require.True(t, zero.IsZero())
+	require.True(t, zero.Currency().IsZero())
+}
+
+func TestArithmetic(t *testing.T) {
+	testcases := []struct {
+		a, b     Money
+		add, sub string
+		cmp      int
+		wantErr  error
+	}{
+		{MustParse("12.34", "USD"), MustParse("0.66", "USD"), "13.00 USD", "11.68 USD", 1, nil},
+		{MustParse("-1.5", "EUR"), MustParse("1.5", "EUR"), "0.00 EUR", "-3.00 EUR", -1, nil},
+		{MustParse("100", "JPY"), MustParse("100", "JPY"), "200 JPY", "0 JPY", 0, nil},
+		{MustParse("1.001", "BHD"), MustParse("0.0001", "BHD"), "1.0011 BHD", "1.0009 BHD", 1, nil},
+		{MustParse("100", "USD"), MustParse("100", "JPY"), "", "", 0, ErrCurrencyMismatch},
+		{MustParse("100", "USD"), Money{}, "", "", 0, ErrCurrencyMismatch},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s", tc.a, tc.b), func(t *testing.T) {
+			add, err := tc.a.Add(tc.b)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+
+				_, err = tc.a.Sub(tc.b)
+				require.ErrorIs(t, err, tc.wantErr)
+
+				_, err = tc.a.Cmp(tc.b)
+				require.ErrorIs(t, err, tc.wantErr)
+
+				require.False(t, tc.a.Equal(tc.b))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.add, add.String())
+
+			sub, err := tc.a.Sub(tc.b)
+			require.NoError(t, err)
+			require.Equal(t, tc.sub, sub.String())
+
+			cmp, err := tc.a.Cmp(tc.b)
+			require.NoError(t, err)
+			require.Equal(t, tc.cmp, cmp)
+			require.Equal(t, tc.cmp == 0, tc.a.Equal(tc.b))
+		})
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	m := MustParse("19.99", "USD")
+
+	require.Equal(t, "59.97 USD", m.Mul(udecimal.MustParse("3")).String())
+	require.Equal(t, "1.69915 USD", m.Mul(udecimal.MustParse("0.085")).String())
+
+	q, err := m.Div(udecimal.MustParse("3"))
+	require.NoError(t, err)
+	require.Equal(t, "6.6633333333333333333 USD", q.String())
+
+	_, err = m.Div(udecimal.Zero)
+	require.Equal(t, udecimal.ErrDivideByZero, err)
+
+	require.Equal(t, "-19.99 USD", m.Neg().String())
+	require.Equal(t, "19.99 USD", m.Neg().Abs().String())
+	require.Equal(t, -1, m.Neg().Sign())
+	require.Equal(t, 1, m.Sign())
+	require.True(t, m.IsPos())
+	require.True(t, m.Neg().IsNeg())
+	require.False(t, m.IsZero())
+}
+
+func TestRound(t *testing.T) {
+	testcases := []struct {
+		m       Money
+		mode    udecimal.RoundingMode
+		want    string
+		wantErr error
+	}{
+		{MustParse("12.345", "USD"), udecimal.RoundHalfEven, "12.34 USD", nil},
+		{MustParse("12.355", "USD"), udecimal.RoundHalfEven, "12.36 USD", nil},
+		{MustParse("12.345", "USD"), udecimal.RoundHalfUp, "12.35 USD", nil},
+		{MustParse("-12.341", "USD"), udecimal.RoundFloor, "-12.35 USD", nil},
+		{MustParse("12.5", "JPY"), udecimal.RoundHalfUp, "13 JPY", nil},
+		{MustParse("12.5", "JPY"), udecimal.RoundHalfEven, "12 JPY", nil},
+		{MustParse("1.23456", "BHD"), udecimal.RoundDown, "1.234 BHD", nil},
+		{MustParse("1.23456", "BHD"), udecimal.RoundUp, "1.235 BHD", nil},
+		{MustParse("12.3", "USD"), udecimal.RoundUnnecessary, "12.30 USD", nil},
+		{MustParse("12.345", "USD"), udecimal.RoundUnnecessary, "", udecimal.ErrRoundingNecessary},
+		{MustParse("12.345", "USD"), udecimal.RoundingMode(100), "", udecimal.ErrInvalidRoundingMode},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s", tc.m, tc.mode), func(t *testing.T) {
+			r, err := tc.m.Round(tc.mode)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				require.False(t, tc.m.IsRounded())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, r.String())
+			require.True(t, r.IsRounded())
+			require.Equal(t, tc.m.Currency(), r.Currency())
+		})
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	testcases := []struct {
+		m       Money
+		want    int64
+		wantErr error
+	}{
+		{MustParse("12.34", "USD"), 1234, nil},
+		{MustParse("12.340", "USD"), 1234, nil},
+		{MustParse("-0.05", "USD"), -5, nil},
+		{MustParse("1234", "JPY"), 1234, nil},
+		{MustParse("1.5", "BHD"), 1500, nil},
+		{MustParse("12.345", "USD"), 0, udecimal.ErrRoundingNecessary},
+		{MustParse("0.5", "JPY"), 0, udecimal.ErrRoundingNecessary},
+		{MustParse("92233720368547758.08", "USD"), 0, udecimal.ErrIntPartOverflow},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.m.String(), func(t *testing.T) {
+			units, err := tc.m.MinorUnits()
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, units)
+			require.True(t, tc.m.Equal(NewFromMinorUnits(units, tc.m.Currency())))
+		})
+	}
+}
+
+func TestAllocateSplit(t *testing.T) {
+	m := MustParse("100", "USD")
+
+	parts, err := m.Split(3)
+	require.NoError(t, err)
+	require.Equal(t, "[33.34 USD 33.33 USD 33.33 USD]", fmt.Sprint(parts))
+
+	parts, err = MustParse("100", "JPY").Split(3)
+	require.NoError(t, err)
+	require.Equal(t, "[34 JPY 33 JPY 33 JPY]", fmt.Sprint(parts))
+
+	parts, err = MustParse("0.010", "BHD").Allocate(udecimal.MustParse("1"), udecimal.MustParse("2"))
+	require.NoError(t, err)
+	require.Equal(t, "[0.003 BHD 0.007 BHD]", fmt.Sprint(parts))
+
+	parts, err = m.Allocate(udecimal.MustParse("0.5"), udecimal.MustParse("0.25"))
+	require.NoError(t, err)
+	require.Equal(t, "[66.67 USD 33.33 USD]", fmt.Sprint(parts))
+
+	_, err = MustParse("12.345", "USD").Split(2)
+	require.Equal(t, udecimal.ErrPrecOutOfRange, err)
+
+	_, err = m.Split(0)
+	require.Equal(t, udecimal.ErrInvalidRatios, err)
+
+	_, err = m.Allocate()
+	require.Equal(t, udecimal.ErrInvalidRatios, err)
+}
@@ -0,0 +1,153 @@
+package udecimal
+
+import "fmt"
+
+// OverflowMode controls what the arithmetic operations of a [Context] do when the coefficient
+// of the result doesn't fit in 128 bits.
+type OverflowMode uint8
+
+const (
+	// OverflowModeBigInt switches to *big.Int when the coefficient of the result doesn't fit in 128 bits,
+	// same as [Decimal.Add], [Decimal.Sub] and [Decimal.Mul]. This is the default mode.
+	OverflowModeBigInt OverflowMode = iota
+
+	// OverflowModeError returns an [*OverflowError] instead, same as [Decimal.AddChecked], [Decimal.SubChecked]
+	// and [Decimal.MulChecked]. Use this mode to make sure the operations never allocate and
+	// the magnitude of the results stays bounded.
+	OverflowModeError
+)
+
+// OverflowError is returned when the coefficient of the result of a checked operation doesn't fit in 128 bits.
+// It matches [ErrOverflow] with errors.Is.
+type OverflowError struct {
+	// Op is the operator: "+", "-" or "*"
+	Op string
+
+	// D and E are the operands
+	D, E Decimal
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("%v: %s %s %s", ErrOverflow, e.D, e.Op, e.E)
+}
+
+// Unwrap returns [ErrOverflow].
+func (e *OverflowError) Unwrap() error {
+	return ErrOverflow
+}
+
+// AddChecked returns d + e.
+//
+// Unlike [Decimal.Add], it returns an [*OverflowError] instead of switching to *big.Int
+// when the coefficient of the result doesn't fit in 128 bits.
+// It doesn't allocate when the coefficients of d, e and the result fit in 128 bits.
+func (d Decimal) AddChecked(e Decimal) (Decimal, error) {
+	if v, err := tryAddU128(d, e); err == nil {
+		return v, nil
+	}
+
+	if v, ok := fitU128(d.Add(e)); ok {
+		return v, nil
+	}
+
+	return Decimal{}, &OverflowError{Op: "+", D: d, E: e}
+}
+
+// SubChecked returns d - e.
+//
+// Unlike [Decimal.Sub], it returns an [*OverflowError] instead of switching to *big.Int
+// when the coefficient of the result doesn't fit in 128 bits.
+// It doesn't allocate when the coefficients of d, e and the result fit in 128 bits.
+func (d Decimal) SubChecked(e Decimal) (Decimal, error) {
+	if v, err := tryAddU128(d, e.Neg()); err == nil {
+		return v, nil
+	}
+
+	if v, ok := fitU128(d.Sub(e)); ok {
+		return v, nil
+	}
+
+	return Decimal{}, &OverflowError{Op: "-", D: d, E: e}
+}
+
+// MulChecked returns d * e.
+// Same as [Decimal.Mul], the result is truncated if it has more digits after the decimal point than the default precision,
+// see [SetDefaultPrecision]. Use [Context.Mul] with [OverflowModeError] for another precision.
+//
+// Unlike [Decimal.Mul], it returns an [*OverflowError] instead of switching to *big.Int
+// when the coefficient of the result doesn't fit in 128 bits.
+// It doesn't allocate when the coefficients of d, e and the result fit in 128 bits.
+func (d Decimal) MulChecked(e Decimal) (Decimal, error) {
+	return d.mulChecked(e, defaultPrec)
+}
+
+func (d Decimal) mulChecked(e Decimal, precLimit uint8) (Decimal, error) {
+	if v, err := tryMulU128(d, e, d.neg != e.neg, d.prec+e.prec, precLimit); err == nil {
+		return v, nil
+	}
+
+	if v, ok := fitU128(d.mul(e, precLimit)); ok {
+		return v, nil
+	}
+
+	return Decimal{}, &OverflowError{Op: "*", D: d, E: e}
+}
+
+// tryAddU128 returns d + e using only u128 arithmetic.
+// Returns errOverflow if d, e or any intermediate value doesn't fit in 128 bits.
+func tryAddU128(d, e Decimal) (Decimal, error) {
+	if d.coef.overflow() || e.coef.overflow() {
+		return Decimal{}, errOverflow
+	}
+
+	var (
+		dcoef, ecoef = d.coef.u128, e.coef.u128
+		prec         = max(d.prec, e.prec)
+		err          error
+	)
+
+	if d.prec < prec {
+		dcoef, err = dcoef.Mul(pow10[prec-d.prec])
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	if e.prec < prec {
+		ecoef, err = ecoef.Mul(pow10[prec-e.prec])
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	if d.neg == e.neg {
+		coef, err := dcoef.Add(ecoef)
+		if err != nil {
+			return Decimal{}, err
+		}
+
+		return newDecimal(d.neg, bintFromU128(coef), prec), nil
+	}
+
+	// different sign, subtract can't overflow
+	if dcoef.Cmp(ecoef) > 0 {
+		coef, _ := dcoef.Sub(ecoef)
+		return newDecimal(d.neg, bintFromU128(coef), prec), nil
+	}
+
+	coef, _ := ecoef.Sub(dcoef)
+	return newDecimal(e.neg, bintFromU128(coef), prec), nil
+}
+
+// fitU128 returns d with its coefficient stored in u128, or false if it doesn't fit in 128 bits
+func fitU128(d Decimal) (Decimal, bool) {
+	if !d.coef.overflow() {
+		return d, true
+	}
+
+	if d.coef.bigInt.BitLen() > 128 {
+		return Decimal{}, false
+	}
+
+	return newDecimal(d.neg, compactBint(d.coef.bigInt), d.prec), true
+}
@@ -0,0 +1,178 @@
+package udecimal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const maxU128Str = "340282366920938463463374607431768211455"
+
+func TestAddChecked(t *testing.T) {
+	testcases := []struct {
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{"1.23", "4.5", "5.73", nil},
+		{"-1.23", "4.5", "3.27", nil},
+		{"1.23", "-1.23", "0", nil},
+		{maxU128Str, "0", maxU128Str, nil},
+		{maxU128Str, "-1", "340282366920938463463374607431768211454", nil},
+		{"-" + maxU128Str, "1", "-340282366920938463463374607431768211454", nil},
+		{maxU128Str, "1", "", ErrOverflow},
+		{"-" + maxU128Str, "-1", "", ErrOverflow},
+		{"34028236692093846346337460743176821145.5", "0.1", "", ErrOverflow},
+		{"100000000000000000000", "0.0000000000000000001", "", ErrOverflow},
+
+		// the coefficients don't fit in 128 bits after scaling, but the result does
+		{"34028236692093846346337460743176821146", "-0.6", "34028236692093846346337460743176821145.4", nil},
+
+		// big.Int input
+		{"340282366920938463463374607431768211456", "-1", maxU128Str, nil},
+		{"340282366920938463463374607431768211456", "1", "", ErrOverflow},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s+%s", tc.a, tc.b), func(t *testing.T) {
+			a, b := MustParse(tc.a), MustParse(tc.b)
+
+			c, err := a.AddChecked(b)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.Greater(t, a.Add(b).coef.GetBig().BitLen(), 128)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+			require.False(t, c.coef.overflow())
+			require.True(t, a.Add(b).Equal(c))
+		})
+	}
+}
+
+func TestSubChecked(t *testing.T) {
+	testcases := []struct {
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{"5.73", "1.23", "4.5", nil},
+		{"1.23", "5.73", "-4.5", nil},
+		{"-1", "-1", "0", nil},
+		{maxU128Str, maxU128Str, "0", nil},
+		{maxU128Str, "1", "340282366920938463463374607431768211454", nil},
+		{maxU128Str, "-1", "", ErrOverflow},
+		{"-" + maxU128Str, "1", "", ErrOverflow},
+		{"34028236692093846346337460743176821146", "0.6", "34028236692093846346337460743176821145.4", nil},
+		{"340282366920938463463374607431768211456", "1", maxU128Str, nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s-%s", tc.a, tc.b), func(t *testing.T) {
+			a, b := MustParse(tc.a), MustParse(tc.b)
+
+			c, err := a.SubChecked(b)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.Greater(t, a.Sub(b).coef.GetBig().BitLen(), 128)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+			require.False(t, c.coef.overflow())
+			require.True(t, a.Sub(b).Equal(c))
+		})
+	}
+}
+
+func TestMulChecked(t *testing.T) {
+	testcases := []struct {
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{"1.5", "-2", "-3", nil},
+		{"0", maxU128Str, "0", nil},
+		{maxU128Str, "1", maxU128Str, nil},
+		{"18446744073709551615", "18446744073709551617", maxU128Str, nil},
+		{"18446744073709551616", "18446744073709551616", "", ErrOverflow},
+		{maxU128Str, "-2", "", ErrOverflow},
+		{"0.1234567890123456789", "0.1234567890123456789", "0.0152415787532388367", nil},
+		{"3402823669209384634.6337460743176821145", "1.0000000000000000001", "3402823669209384634.9740284412386205779", nil},
+		{"34028236692093846346.3374607431768211455", "1.0000000000000000001", "", ErrOverflow},
+		{"34028236692093846346.3374607431768211456", "0.5", "17014118346046923173.1687303715884105728", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s*%s", tc.a, tc.b), func(t *testing.T) {
+			a, b := MustParse(tc.a), MustParse(tc.b)
+
+			c, err := a.MulChecked(b)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.Greater(t, a.Mul(b).coef.GetBig().BitLen(), 128)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, c.String())
+			require.False(t, c.coef.overflow())
+			require.True(t, a.Mul(b).Equal(c))
+		})
+	}
+}
+
+func TestMulCheckedDefaultPrecision(t *testing.T) {
+	defer SetDefaultPrecision(maxPrec)
+
+	a, b := MustParse("0.1234567890123456789"), MustParse("0.1234567890123456789")
+	SetDefaultPrecision(2)
+
+	// same as Mul
+	c, err := a.MulChecked(b)
+	require.NoError(t, err)
+	require.Equal(t, "0.01", c.String())
+	require.Equal(t, a.Mul(b), c)
+
+	c, err = Context{Prec: 19, Overflow: OverflowModeError}.Mul(a, b)
+	require.NoError(t, err)
+	require.Equal(t, "0.0152415787532388367", c.String())
+
+	_, err = MustParse("18446744073709551616").MulChecked(MustParse("18446744073709551616"))
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestOverflowError(t *testing.T) {
+	a, b := MustParse(maxU128Str), MustParse("0.5")
+
+	_, err := a.AddChecked(b)
+	require.EqualError(t, err, "overflow. The coefficient of the result doesn't fit in 128 bits: "+maxU128Str+" + 0.5")
+
+	var oerr *OverflowError
+	require.True(t, errors.As(err, &oerr))
+	require.Equal(t, OverflowError{Op: "+", D: a, E: b}, *oerr)
+
+	_, err = a.Neg().SubChecked(b)
+	require.EqualError(t, err, "overflow. The coefficient of the result doesn't fit in 128 bits: -"+maxU128Str+" - 0.5")
+
+	_, err = a.MulChecked(MustParse("1.5"))
+	require.EqualError(t, err, "overflow. The coefficient of the result doesn't fit in 128 bits: "+maxU128Str+" * 1.5")
+}
+
+func TestCheckedNoAlloc(t *testing.T) {
+	a := MustParse("3402823669209.3846346337460743176")
+	b := MustParse("-1234567.891")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = a.AddChecked(b)
+		_, _ = a.SubChecked(b)
+		_, _ = b.MulChecked(b)
+		_, _ = a.MulChecked(b)
+	})
+	require.Equal(t, float64(0), allocs)
+}
@@ -0,0 +1,234 @@
+package udecimal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// ParseErrorReason is the reason why a string can't be parsed to a decimal.
+type ParseErrorReason uint8
+
+const (
+	// ReasonEmptyString means the input is empty. It matches [ErrEmptyString].
+	ReasonEmptyString ParseErrorReason = iota + 1
+
+	// ReasonTooLong means the input is longer than 200 characters,
+	// or the number in scientific notation has more than 200 digits. It matches [ErrMaxStrLen].
+	ReasonTooLong
+
+	// ReasonUnexpectedChar means the character at Offset isn't allowed there, e.g. 'c' in "12c45" or '.' in "1.2.3".
+	// It matches [ErrInvalidFormat].
+	ReasonUnexpectedChar
+
+	// ReasonUnexpectedEnd means the input ends where a digit is expected, e.g. "123.", "-" or "1e".
+	// It matches [ErrInvalidFormat].
+	ReasonUnexpectedEnd
+
+	// ReasonPrecOutOfRange means the number has too many digits after the decimal point,
+	// Offset is the first extra digit, e.g. '3' in "1.23e-18" with 19 digits.
+	// In scientific notation, if no nonzero digit is within the limit, the exponent is what makes the number too small,
+	// so Offset is the start of the exponent, e.g. '-' in "1e-20". It matches [ErrPrecOutOfRange].
+	ReasonPrecOutOfRange
+)
+
+// String returns the description of the reason.
+func (r ParseErrorReason) String() string {
+	switch r {
+	case ReasonEmptyString:
+		return "empty string"
+	case ReasonTooLong:
+		return "too long"
+	case ReasonUnexpectedChar:
+		return "unexpected character"
+	case ReasonUnexpectedEnd:
+		return "unexpected end of input"
+	case ReasonPrecOutOfRange:
+		return "too many digits after the decimal point"
+	default:
+		return fmt.Sprintf("ParseErrorReason(%d)", r)
+	}
+}
+
+// ParseError is returned when a string can't be parsed to a decimal,
+// e.g. by [Parse], [Decimal.UnmarshalText], [Decimal.UnmarshalJSON] and [Decimal.Scan].
+//
+// It matches the error of its reason with errors.Is, e.g. errors.Is(err, ErrInvalidFormat),
+// so existing checks keep working. Use errors.As to get the details:
+//
+//	var perr *udecimal.ParseError
+//	if errors.As(err, &perr) {
+//		fmt.Println(perr.Offset, perr.Reason)
+//	}
+type ParseError struct {
+	// Input is the string being parsed. For UnmarshalJSON, it doesn't include the quotes.
+	Input string
+
+	// Offset is the byte offset in Input where the problem was found
+	Offset int
+
+	// Reason is the reason why Input can't be parsed
+	Reason ParseErrorReason
+}
+
+func (e *ParseError) Error() string {
+	switch e.Reason {
+	case ReasonEmptyString, ReasonTooLong:
+		return e.Unwrap().Error()
+	case ReasonUnexpectedChar:
+		r, _ := utf8.DecodeRuneInString(e.Input[e.Offset:])
+		return fmt.Sprintf("%v: can't parse '%s' at offset %d: unexpected character %q", e.Unwrap(), e.Input, e.Offset, r)
+	default:
+		return fmt.Sprintf("%v: can't parse '%s' at offset %d: %s", e.Unwrap(), e.Input, e.Offset, e.Reason)
+	}
+}
+
+// Unwrap returns the error matching the reason, e.g. [ErrInvalidFormat] for [ReasonUnexpectedChar].
+func (e *ParseError) Unwrap() error {
+	switch e.Reason {
+	case ReasonEmptyString:
+		return ErrEmptyString
+	case ReasonTooLong:
+		return ErrMaxStrLen
+	case ReasonPrecOutOfRange:
+		return ErrPrecOutOfRange
+	default:
+		return ErrInvalidFormat
+	}
+}
+
+// parseError converts err returned when parsing s to a *ParseError.
+// It runs only when parsing fails, so the parsers don't have to keep track of positions.
+func (c Context) parseError(s []byte, err error) error {
+	switch {
+	case errors.Is(err, ErrEmptyString):
+		return &ParseError{Reason: ReasonEmptyString}
+	case errors.Is(err, ErrMaxStrLen):
+		offset := maxStrLen
+		if len(s) <= maxStrLen {
+			// too many digits in scientific notation, because of the exponent
+			offset = exponentIndex(s) + 1
+		}
+
+		return &ParseError{Input: string(s), Offset: offset, Reason: ReasonTooLong}
+	case errors.Is(err, ErrPrecOutOfRange):
+		return &ParseError{Input: string(s), Offset: c.extraDigitOffset(s), Reason: ReasonPrecOutOfRange}
+	case errors.Is(err, ErrInvalidFormat):
+		offset, reason := invalidFormatOffset(s)
+		return &ParseError{Input: string(s), Offset: offset, Reason: reason}
+	default:
+		// not caused by the input, e.g. invalid parse mode
+		return err
+	}
+}
+
+// invalidFormatOffset returns the offset of the first character of s which doesn't match the format:
+// [+-]d+[.d+][(e|E)[+-]d+]
+func invalidFormatOffset(s []byte) (int, ParseErrorReason) {
+	i := skipSign(s, 0)
+
+	j := skipDigits(s, i)
+	if j == i {
+		return i, unexpectedAt(s, i)
+	}
+
+	if i = j; i < len(s) && s[i] == '.' {
+		i++
+
+		j = skipDigits(s, i)
+		if j == i {
+			return i, unexpectedAt(s, i)
+		}
+
+		i = j
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i = skipSign(s, i+1)
+
+		j = skipDigits(s, i)
+		if j == i {
+			return i, unexpectedAt(s, i)
+		}
+
+		i = j
+	}
+
+	if i < len(s) {
+		return i, ReasonUnexpectedChar
+	}
+
+	// s has the correct format, which shouldn't happen for ErrInvalidFormat
+	return 0, ReasonUnexpectedChar
+}
+
+func skipSign(s []byte, i int) int {
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		return i + 1
+	}
+
+	return i
+}
+
+func skipDigits(s []byte, i int) int {
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+
+	return i
+}
+
+// unexpectedAt returns the reason when a digit is expected at s[i]
+func unexpectedAt(s []byte, i int) ParseErrorReason {
+	if i == len(s) {
+		return ReasonUnexpectedEnd
+	}
+
+	return ReasonUnexpectedChar
+}
+
+// extraDigitOffset returns the offset of the first digit of s after the first c.prec() digits after the decimal point.
+// In scientific notation, the decimal point is moved by the exponent first, and the offset is the start of the exponent
+// if no nonzero digit is before the first extra digit.
+func (c Context) extraDigitOffset(s []byte) int {
+	end, exp, expOffset := len(s), 0, -1
+	if ePos := exponentIndex(s); ePos >= 0 {
+		end, expOffset = ePos, ePos+1
+		exp, _ = parseExponent(s[ePos+1:])
+	}
+
+	mantissa := s[:end]
+
+	intDigits := bytes.IndexByte(mantissa, '.')
+	if intDigits < 0 {
+		intDigits = len(mantissa)
+	}
+
+	if len(mantissa) > 0 && (mantissa[0] == '+' || mantissa[0] == '-') {
+		intDigits--
+	}
+
+	// index of the first extra digit, ignoring the sign and the decimal point
+	n := max(intDigits+exp+int(c.prec()), 0)
+	nonzero := false
+
+	for i, ch := range mantissa {
+		if ch < '0' || ch > '9' {
+			continue
+		}
+
+		if n == 0 {
+			if !nonzero && expOffset >= 0 {
+				return expOffset
+			}
+
+			return i
+		}
+
+		nonzero = nonzero || ch != '0'
+		n--
+	}
+
+	return end
+}
@@ -0,0 +1,116 @@
+package udecimal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	testcases := []struct {
+		ctx        Context
+		input      string
+		wantOffset int
+		wantReason ParseErrorReason
+		wantErr    error
+	}{
+		{DefaultContext(), "", 0, ReasonEmptyString, ErrEmptyString},
+		{DefaultContext(), strings.Repeat("1", maxStrLen+1), maxStrLen, ReasonTooLong, ErrMaxStrLen},
+		{DefaultContext(), "1e200", 2, ReasonTooLong, ErrMaxStrLen},
+		{DefaultContext(), "12c45.6", 2, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "1.2.3", 3, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "12345..123456", 6, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), ".5", 0, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "+.5", 1, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "--1", 1, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), " 1", 0, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "1 ", 1, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "123456789012345678901234567890.12x", 33, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "1.5ex", 4, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "1e5.0", 3, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "1€", 1, ReasonUnexpectedChar, ErrInvalidFormat},
+		{DefaultContext(), "123.", 4, ReasonUnexpectedEnd, ErrInvalidFormat},
+		{DefaultContext(), "-", 1, ReasonUnexpectedEnd, ErrInvalidFormat},
+		{DefaultContext(), "1e", 2, ReasonUnexpectedEnd, ErrInvalidFormat},
+		{DefaultContext(), "1e+", 3, ReasonUnexpectedEnd, ErrInvalidFormat},
+		{DefaultContext(), "0.12345678901234567890", 21, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "-0.12345678901234567890", 22, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "12345678901234567890123.12345678901234567890", 43, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "1.23456789012345678901e-1", 20, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "1e-20", 2, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "1E-25", 2, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "-0.1e-19", 5, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "1.5e-19", 2, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{DefaultContext(), "1.23e-18", 3, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{Context{Prec: 2}, "5e-3", 2, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{Context{Prec: 2}, "1.234", 4, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{Context{Prec: 0}, "+1.5", 3, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{Context{Prec: 2}, "1.2345e1", 5, ReasonPrecOutOfRange, ErrPrecOutOfRange},
+		{Context{Prec: 2, ParseMode: ParseModeTrunc}, "1.23a", 4, ReasonUnexpectedChar, ErrInvalidFormat},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%+v %s", tc.ctx, tc.input), func(t *testing.T) {
+			_, err := tc.ctx.Parse(tc.input)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			var perr *ParseError
+			require.ErrorAs(t, err, &perr)
+			require.Equal(t, tc.input, perr.Input)
+			require.Equal(t, tc.wantOffset, perr.Offset)
+			require.Equal(t, tc.wantReason, perr.Reason)
+		})
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	testcases := []struct {
+		input string
+		want  string
+	}{
+		{"", "can't parse empty string"},
+		{strings.Repeat("1", maxStrLen+1), "string input exceeds maximum length 200"},
+		{"12c45", "invalid format: can't parse '12c45' at offset 2: unexpected character 'c'"},
+		{"1€", "invalid format: can't parse '1€' at offset 1: unexpected character '€'"},
+		{"123.", "invalid format: can't parse '123.' at offset 4: unexpected end of input"},
+		{"0.12345678901234567890", "precision out of range. Only support maximum 19 digits after the decimal point: can't parse '0.12345678901234567890' at offset 21: too many digits after the decimal point"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := Parse(tc.input)
+			require.EqualError(t, err, tc.want)
+		})
+	}
+
+	require.Equal(t, "ParseErrorReason(0)", ParseErrorReason(0).String())
+}
+
+func TestParseErrorUnmarshal(t *testing.T) {
+	var d Decimal
+
+	var perr *ParseError
+	require.ErrorAs(t, d.UnmarshalText([]byte("1.2.3")), &perr)
+	require.Equal(t, ParseError{Input: "1.2.3", Offset: 3, Reason: ReasonUnexpectedChar}, *perr)
+
+	require.ErrorAs(t, d.UnmarshalJSON([]byte(`"12a"`)), &perr)
+	require.Equal(t, ParseError{Input: "12a", Offset: 2, Reason: ReasonUnexpectedChar}, *perr)
+
+	require.ErrorAs(t, d.Scan("1."), &perr)
+	require.Equal(t, ParseError{Input: "1.", Offset: 2, Reason: ReasonUnexpectedEnd}, *perr)
+
+	require.ErrorAs(t, d.Scan([]byte("0.12345678901234567890")), &perr)
+	require.Equal(t, ParseError{Input: "0.12345678901234567890", Offset: 21, Reason: ReasonPrecOutOfRange}, *perr)
+}
+
+func TestParseErrorInvalidParseMode(t *testing.T) {
+	// not caused by the input, so it isn't a *ParseError
+	_, err := Context{Prec: 19, ParseMode: 2}.Parse("1.123456789012345678999")
+
+	var perr *ParseError
+	require.Error(t, err)
+	require.False(t, errors.As(err, &perr))
+}
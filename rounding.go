@@ -0,0 +1,117 @@
+package udecimal
+
+import "fmt"
+
+// RoundingMode specifies how a number is rounded when digits have to be discarded.
+// The zero value is [RoundDown], which truncates the discarded digits like [Decimal.Div] does.
+type RoundingMode uint8
+
+const (
+	// RoundDown rounds toward zero, i.e. the discarded digits are truncated.
+	//
+	//	1.15 -> 1.1, -1.15 -> -1.1
+	RoundDown RoundingMode = iota
+
+	// RoundUp rounds away from zero.
+	//
+	//	1.11 -> 1.2, -1.11 -> -1.2
+	RoundUp
+
+	// RoundCeiling rounds toward positive infinity.
+	//
+	//	1.11 -> 1.2, -1.19 -> -1.1
+	RoundCeiling
+
+	// RoundFloor rounds toward negative infinity.
+	//
+	//	1.19 -> 1.1, -1.11 -> -1.2
+	RoundFloor
+
+	// RoundHalfUp rounds to the nearest neighbor, or away from zero if both neighbors are equidistant.
+	//
+	//	1.15 -> 1.2, -1.15 -> -1.2
+	RoundHalfUp
+
+	// RoundHalfDown rounds to the nearest neighbor, or toward zero if both neighbors are equidistant.
+	//
+	//	1.15 -> 1.1, -1.15 -> -1.1
+	RoundHalfDown
+
+	// RoundHalfEven rounds to the nearest neighbor, or to the even neighbor if both neighbors are equidistant.
+	// Also known as banker's rounding.
+	//
+	//	1.15 -> 1.2, 1.25 -> 1.2, -1.25 -> -1.2
+	RoundHalfEven
+
+	// RoundHalfCeiling rounds to the nearest neighbor, or toward positive infinity if both neighbors are equidistant.
+	//
+	//	1.15 -> 1.2, -1.15 -> -1.1
+	RoundHalfCeiling
+
+	// RoundHalfFloor rounds to the nearest neighbor, or toward negative infinity if both neighbors are equidistant.
+	//
+	//	1.15 -> 1.1, -1.15 -> -1.2
+	RoundHalfFloor
+
+	// RoundUnnecessary asserts that the result is exact, so no rounding is necessary.
+	// Operations return [ErrRoundingNecessary] if any non-zero digit would be discarded.
+	//
+	//	1.10 -> 1.1, 1.15 -> error
+	RoundUnnecessary
+)
+
+var roundingModeNames = [...]string{
+	RoundDown:        "RoundDown",
+	RoundUp:          "RoundUp",
+	RoundCeiling:     "RoundCeiling",
+	RoundFloor:       "RoundFloor",
+	RoundHalfUp:      "RoundHalfUp",
+	RoundHalfDown:    "RoundHalfDown",
+	RoundHalfEven:    "RoundHalfEven",
+	RoundHalfCeiling: "RoundHalfCeiling",
+	RoundHalfFloor:   "RoundHalfFloor",
+	RoundUnnecessary: "RoundUnnecessary",
+}
+
+// String returns the name of the rounding mode, e.g. "RoundHalfEven".
+func (m RoundingMode) String() string {
+	if !m.valid() {
+		return fmt.Sprintf("RoundingMode(%d)", m)
+	}
+
+	return roundingModeNames[m]
+}
+
+func (m RoundingMode) valid() bool {
+	return m <= RoundUnnecessary
+}
+
+// roundUp reports whether the magnitude of a truncated result must be increased by one unit
+// in the last place when the discarded part is non-zero.
+// It always returns false for [RoundUnnecessary], callers must check the discarded part themselves.
+//
+//   - neg: true if the result is negative
+//   - odd: true if the truncated result is odd
+//   - half: result of comparing the discarded part with half a unit (-1, 0 or +1)
+func (m RoundingMode) roundUp(neg, odd bool, half int) bool {
+	switch m {
+	case RoundUp:
+		return true
+	case RoundCeiling:
+		return !neg
+	case RoundFloor:
+		return neg
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd)
+	case RoundHalfCeiling:
+		return half > 0 || (half == 0 && !neg)
+	case RoundHalfFloor:
+		return half > 0 || (half == 0 && neg)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package udecimal
+
+// AddSat returns d + e, saturated to the largest value whose coefficient fits in 128 bits
+// at the precision of the result, e.g. 34028236692093846346337460743176821145.5 for a result with 1 digit after the decimal point.
+// The returned bool reports whether the result was saturated.
+//
+// Use [Context.AddSat] with [Context.MaxMagnitude] to saturate to another bound.
+// It doesn't allocate when the coefficients of d, e and the unsaturated result fit in 128 bits.
+func (d Decimal) AddSat(e Decimal) (Decimal, bool) {
+	return d.addSat(e, Zero)
+}
+
+// SubSat returns d - e, saturated to the largest value whose coefficient fits in 128 bits
+// at the precision of the result. The returned bool reports whether the result was saturated.
+//
+// Use [Context.SubSat] with [Context.MaxMagnitude] to saturate to another bound.
+// It doesn't allocate when the coefficients of d, e and the unsaturated result fit in 128 bits.
+func (d Decimal) SubSat(e Decimal) (Decimal, bool) {
+	return d.addSat(e.Neg(), Zero)
+}
+
+// MulSat returns d * e, saturated to the largest value whose coefficient fits in 128 bits
+// at the precision of the result. The returned bool reports whether the result was saturated.
+// Same as [Decimal.Mul], the result is truncated if it has more digits after the decimal point than the default precision,
+// see [SetDefaultPrecision].
+//
+// Use [Context.MulSat] with [Context.Prec] and [Context.MaxMagnitude] for another precision or bound.
+// It doesn't allocate when the coefficients of d, e and the unsaturated result fit in 128 bits.
+func (d Decimal) MulSat(e Decimal) (Decimal, bool) {
+	return d.mulSat(e, defaultPrec, Zero)
+}
+
+// Clamp returns d limited to the range [lo, hi].
+// The returned bool reports whether d was outside of the range.
+// If lo > hi, they are swapped.
+func (d Decimal) Clamp(lo, hi Decimal) (Decimal, bool) {
+	if lo.GreaterThan(hi) {
+		lo, hi = hi, lo
+	}
+
+	if d.LessThan(lo) {
+		return lo, true
+	}
+
+	if d.GreaterThan(hi) {
+		return hi, true
+	}
+
+	return d, false
+}
+
+// addSat returns d + e, saturated to maxMag. If maxMag is zero, the result is saturated to the
+// largest value whose coefficient fits in 128 bits at the precision of the result.
+func (d Decimal) addSat(e, maxMag Decimal) (Decimal, bool) {
+	v, err := tryAddU128(d, e)
+	if err != nil {
+		v = d.Add(e)
+	}
+
+	return v.saturate(maxMag)
+}
+
+// mulSat returns d * e truncated to precLimit digits after the decimal point, saturated to maxMag.
+// If maxMag is zero, the result is saturated to the largest value whose coefficient fits in 128 bits
+// at the precision of the result.
+func (d Decimal) mulSat(e Decimal, precLimit uint8, maxMag Decimal) (Decimal, bool) {
+	v, err := tryMulU128(d, e, d.neg != e.neg, d.prec+e.prec, precLimit)
+	if err != nil {
+		v = d.mul(e, precLimit)
+	}
+
+	return v.saturate(maxMag)
+}
+
+// saturate limits |d| to |maxMag|, keeping the sign of d.
+// If maxMag is zero, |d| is limited to the largest value whose coefficient fits in 128 bits at the precision of d.
+func (d Decimal) saturate(maxMag Decimal) (Decimal, bool) {
+	if maxMag.IsZero() {
+		if v, ok := fitU128(d); ok {
+			return v, false
+		}
+
+		return Decimal{neg: d.neg, coef: bintFromU128(max128), prec: d.prec}, true
+	}
+
+	maxMag = maxMag.Abs()
+	if d.Abs().GreaterThan(maxMag) {
+		return newDecimal(d.neg, maxMag.coef, maxMag.prec), true
+	}
+
+	return d, false
+}
@@ -0,0 +1,177 @@
+package udecimal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaturate(t *testing.T) {
+	testcases := []struct {
+		op      string
+		a, b    string
+		want    string
+		wantSat bool
+	}{
+		{"+", "1.23", "4.5", "5.73", false},
+		{"+", maxU128Str, "-1", "340282366920938463463374607431768211454", false},
+		{"+", maxU128Str, "1", maxU128Str, true},
+		{"+", "-" + maxU128Str, "-1", "-" + maxU128Str, true},
+		{"+", "34028236692093846346337460743176821145.5", "0.1", "34028236692093846346337460743176821145.5", true},
+		{"+", "100000000000000000000", "0.0000000000000000001", "34028236692093846346.3374607431768211455", true},
+		{"+", "-100000000000000000000", "-0.0000000000000000001", "-34028236692093846346.3374607431768211455", true},
+		{"+", "34028236692093846346337460743176821146", "-0.6", "34028236692093846346337460743176821145.4", false},
+		{"+", "340282366920938463463374607431768211456", "-1", maxU128Str, false},
+		{"+", "340282366920938463463374607431768211456", "1", maxU128Str, true},
+		{"-", "5.73", "1.23", "4.5", false},
+		{"-", maxU128Str, "-1", maxU128Str, true},
+		{"-", "-" + maxU128Str, "1", "-" + maxU128Str, true},
+		{"-", maxU128Str, maxU128Str, "0", false},
+		{"*", "1.5", "-2", "-3", false},
+		{"*", "18446744073709551615", "18446744073709551617", maxU128Str, false},
+		{"*", "18446744073709551616", "18446744073709551616", maxU128Str, true},
+		{"*", maxU128Str, "-2", "-" + maxU128Str, true},
+		{"*", "0.1234567890123456789", "0.1234567890123456789", "0.0152415787532388367", false},
+		{"*", "34028236692093846346.3374607431768211455", "1.0000000000000000001", "34028236692093846346.3374607431768211455", true},
+		{"*", "-34028236692093846346.3374607431768211455", "1.0000000000000000001", "-34028236692093846346.3374607431768211455", true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s%s%s", tc.a, tc.op, tc.b), func(t *testing.T) {
+			a, b := MustParse(tc.a), MustParse(tc.b)
+
+			var (
+				c   Decimal
+				sat bool
+			)
+
+			switch tc.op {
+			case "+":
+				c, sat = a.AddSat(b)
+			case "-":
+				c, sat = a.SubSat(b)
+			case "*":
+				c, sat = a.MulSat(b)
+			}
+
+			require.Equal(t, tc.want, c.String())
+			require.Equal(t, tc.wantSat, sat)
+			require.False(t, c.coef.overflow())
+		})
+	}
+}
+
+func TestContextSaturate(t *testing.T) {
+	testcases := []struct {
+		ctx     Context
+		op      string
+		a, b    string
+		want    string
+		wantSat bool
+	}{
+		{Context{MaxMagnitude: MustParse("1000000")}, "+", "999999.5", "0.5", "1000000", false},
+		{Context{MaxMagnitude: MustParse("1000000")}, "+", "999999.5", "1", "1000000", true},
+		{Context{MaxMagnitude: MustParse("1000000")}, "+", "-999999.5", "-1", "-1000000", true},
+		{Context{MaxMagnitude: MustParse("-1000000")}, "+", "-999999.5", "-1", "-1000000", true},
+		{Context{MaxMagnitude: MustParse("1000000")}, "+", maxU128Str, "1", "1000000", true},
+		{Context{MaxMagnitude: MustParse("1e50")}, "+", "1e45", "1e45", "2000000000000000000000000000000000000000000000", false},
+		{Context{MaxMagnitude: MustParse("1e50")}, "+", "1e50", "0.1", "100000000000000000000000000000000000000000000000000", true},
+		{Context{MaxMagnitude: MustParse("1000000")}, "-", "-1000000", "0.01", "-1000000", true},
+		{Context{MaxMagnitude: MustParse("1000000")}, "-", "1000000", "0.01", "999999.99", false},
+		{Context{Prec: 2, MaxMagnitude: MustParse("1000000")}, "*", "1.23", "1.23", "1.51", false},
+		{Context{Prec: 2, MaxMagnitude: MustParse("1000000")}, "*", "1000", "1000.001", "1000000", true},
+		{Context{Prec: 2, MaxMagnitude: MustParse("1000000")}, "*", "-1000", "1000.001", "-1000000", true},
+		{Context{Prec: 2}, "*", "18446744073709551616", "18446744073709551616", maxU128Str, true},
+		{Context{}, "+", maxU128Str, "1", maxU128Str, true},
+		{Context{MaxMagnitude: MustParse("0.00")}, "+", maxU128Str, "-1", "340282366920938463463374607431768211454", false},
+		{Context{Prec: 19}, "*", "0.1234567890123456789", "0.1234567890123456789", "0.0152415787532388367", false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%v %s%s%s", tc.ctx.MaxMagnitude, tc.a, tc.op, tc.b), func(t *testing.T) {
+			a, b := MustParse(tc.a), MustParse(tc.b)
+
+			var (
+				c   Decimal
+				sat bool
+			)
+
+			switch tc.op {
+			case "+":
+				c, sat = tc.ctx.AddSat(a, b)
+			case "-":
+				c, sat = tc.ctx.SubSat(a, b)
+			case "*":
+				c, sat = tc.ctx.MulSat(a, b)
+			}
+
+			require.Equal(t, tc.want, c.String())
+			require.Equal(t, tc.wantSat, sat)
+		})
+	}
+}
+
+func TestMulSatDefaultPrecision(t *testing.T) {
+	defer SetDefaultPrecision(maxPrec)
+
+	a, b := MustParse("0.1234567890123456789"), MustParse("0.1234567890123456789")
+	SetDefaultPrecision(2)
+
+	// same as Mul when the product doesn't saturate
+	c, sat := a.MulSat(b)
+	require.False(t, sat)
+	require.Equal(t, "0.01", c.String())
+	require.Equal(t, a.Mul(b), c)
+
+	c, sat = Context{Prec: 19}.MulSat(a, b)
+	require.False(t, sat)
+	require.Equal(t, "0.0152415787532388367", c.String())
+
+	c, sat = MustParse("18446744073709551616").MulSat(MustParse("18446744073709551616"))
+	require.True(t, sat)
+	require.Equal(t, maxU128Str, c.String())
+}
+
+func TestClamp(t *testing.T) {
+	testcases := []struct {
+		d, lo, hi   string
+		want        string
+		wantClamped bool
+	}{
+		{"5", "1", "10", "5", false},
+		{"1", "1", "10", "1", false},
+		{"10", "1", "10", "10", false},
+		{"0.5", "1", "10", "1", true},
+		{"10.0000000000000000001", "1", "10", "10", true},
+		{"-3", "-2", "2", "-2", true},
+		{"11", "10", "1", "10", true},
+		{"0", "10", "1", "1", true},
+		{"5", "5", "5", "5", false},
+		{"340282366920938463463374607431768211456", "0", maxU128Str, maxU128Str, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s in [%s, %s]", tc.d, tc.lo, tc.hi), func(t *testing.T) {
+			got, clamped := MustParse(tc.d).Clamp(MustParse(tc.lo), MustParse(tc.hi))
+			require.Equal(t, tc.want, got.String())
+			require.Equal(t, tc.wantClamped, clamped)
+		})
+	}
+}
+
+func TestSaturateNoAlloc(t *testing.T) {
+	a := MustParse("3402823669209.3846346337460743176")
+	b := MustParse("-1234567.891")
+	lo, hi := MustParse("-1000"), MustParse("1000")
+	ctx := Context{Prec: 2, MaxMagnitude: MustParse("1000000")}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = a.AddSat(b)
+		_, _ = a.SubSat(b)
+		_, _ = b.MulSat(b)
+		_, _ = ctx.MulSat(a, b)
+		_, _ = a.Clamp(lo, hi)
+	})
+	require.Equal(t, float64(0), allocs)
+}
@@ -0,0 +1,21 @@
+// Package stats provides descriptive statistics over slices of [udecimal.Decimal], such as
+// [Sum], [Mean], [Median], [Percentile], [Variance] and [StdDev], without converting the values to float64.
+//
+// Sums are exact: they're accumulated with [udecimal.Accumulator], so the order of the values doesn't change the result.
+// The sums of squares and products used by the variance and the weighted mean are exact too:
+// the values are scaled to integers first, so nothing is truncated, whatever the number of digits.
+//
+// # Precision and rounding
+//
+// The functions which divide take a [udecimal.Context]. The final result is rounded once,
+// to ctx.Prec digits after the decimal point using ctx.Rounding, e.g.
+//
+//	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}
+//	stats.Mean(ctx, xs)
+//
+// This includes [StdDev] and [SampleStdDev]: the square root is computed from the exact variance,
+// not from a variance truncated to 19 digits.
+//
+// Values picked from the input, like the median of an odd number of values
+// or the percentiles of [MethodLower] and [MethodHigher], are returned as is.
+package stats
@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+)
+
+func ExampleSum() {
+	xs := []udecimal.Decimal{udecimal.MustParse("0.1"), udecimal.MustParse("0.2"), udecimal.MustParse("0.3")}
+	fmt.Println(Sum(xs))
+	// Output:
+	// 0.6
+}
+
+func ExampleMean() {
+	xs := []udecimal.Decimal{udecimal.MustParse("1"), udecimal.MustParse("2"), udecimal.MustParse("2")}
+
+	fmt.Println(Mean(udecimal.Context{Prec: 2}, xs))
+	fmt.Println(Mean(udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}, xs))
+	fmt.Println(Mean(udecimal.Context{Prec: 2}, nil))
+	// Output:
+	// 1.66 <nil>
+	// 1.67 <nil>
+	// 0 empty slice
+}
+
+func ExampleWeightedMean() {
+	prices := []udecimal.Decimal{udecimal.MustParse("101.25"), udecimal.MustParse("101.5")}
+	volumes := []udecimal.Decimal{udecimal.MustParse("300"), udecimal.MustParse("100")}
+
+	// volume-weighted average price
+	fmt.Println(WeightedMean(udecimal.Context{Prec: 4, Rounding: udecimal.RoundHalfEven}, prices, volumes))
+	// Output:
+	// 101.3125 <nil>
+}
+
+func ExamplePercentile() {
+	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}
+	latencies := []udecimal.Decimal{
+		udecimal.MustParse("40"), udecimal.MustParse("15"), udecimal.MustParse("50"),
+		udecimal.MustParse("35"), udecimal.MustParse("20"),
+	}
+
+	p40 := udecimal.MustParse("40")
+	fmt.Println(Percentile(ctx, latencies, p40, MethodLinear))
+	fmt.Println(Percentile(ctx, latencies, p40, MethodLower))
+	fmt.Println(Percentile(ctx, latencies, p40, MethodExclusive))
+	fmt.Println(Median(ctx, latencies))
+	// Output:
+	// 29 <nil>
+	// 20 <nil>
+	// 26 <nil>
+	// 35 <nil>
+}
+
+func ExampleStdDev() {
+	ctx := udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}
+
+	var xs []udecimal.Decimal
+	for _, s := range []string{"2", "4", "4", "4", "5", "5", "7", "9"} {
+		xs = append(xs, udecimal.MustParse(s))
+	}
+
+	fmt.Println(Variance(ctx, xs))
+	fmt.Println(StdDev(ctx, xs))
+	fmt.Println(SampleVariance(ctx, xs))
+	fmt.Println(SampleStdDev(ctx, xs))
+	// Output:
+	// 4 <nil>
+	// 2 <nil>
+	// 4.57 <nil>
+	// 2.14 <nil>
+}
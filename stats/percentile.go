@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+// Method is the method used by [Percentile] when the percentile falls between two values.
+//
+// For n sorted values x[0] <= ... <= x[n-1] and a percentile p, the methods except [MethodExclusive]
+// compute the (0-based) position h = (n - 1) * p / 100, with i = floor(h) and f = h - i.
+// The methods match the ones of the same name in NumPy.
+type Method uint8
+
+const (
+	// MethodLinear interpolates between x[i] and x[i+1]: x[i] + f * (x[i+1] - x[i]).
+	// This is the default method of NumPy and Excel's PERCENTILE.INC.
+	MethodLinear Method = iota
+
+	// MethodLower returns x[i]
+	MethodLower
+
+	// MethodHigher returns x[i+1] if f > 0, otherwise x[i]
+	MethodHigher
+
+	// MethodNearest returns the value at the nearest position, x[i] or x[i+1].
+	// When f = 0.5, the value at the even position is returned.
+	MethodNearest
+
+	// MethodMidpoint returns (x[i] + x[i+1]) / 2 if f > 0, otherwise x[i]
+	MethodMidpoint
+
+	// MethodExclusive interpolates like MethodLinear at the position h = (n + 1) * p / 100 - 1,
+	// like Excel's PERCENTILE.EXC. It requires 100 / (n + 1) <= p <= 100 * n / (n + 1).
+	MethodExclusive
+)
+
+var (
+	hundred = udecimal.MustFromInt64(100, 0)
+	fifty   = udecimal.MustFromInt64(50, 0)
+	two     = udecimal.MustFromInt64(2, 0)
+)
+
+// Median returns the middle value of xs, or the mean of the 2 middle values when len(xs) is even,
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+// xs doesn't need to be sorted and isn't modified.
+//
+// Returns [ErrEmpty] if xs is empty.
+//
+// Example:
+//
+//	Median({Prec: 2}, [3, 1, 2]) = 2
+//	Median({Prec: 2}, [4, 1, 3, 2]) = 2.5
+func Median(ctx udecimal.Context, xs []udecimal.Decimal) (udecimal.Decimal, error) {
+	if len(xs) == 0 {
+		return udecimal.Decimal{}, ErrEmpty
+	}
+
+	sorted := sortedCopy(xs)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2], nil
+	}
+
+	return ctx.Div(sorted[n/2-1].Add(sorted[n/2]), two)
+}
+
+// Percentile returns the p-th percentile of xs (0 <= p <= 100) computed with method.
+// Interpolated values are rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+// xs doesn't need to be sorted and isn't modified.
+//
+// Returns error if:
+//   - xs is empty: [ErrEmpty]
+//   - p is out of range: [ErrInvalidPercentile]
+//   - method is not valid: [ErrInvalidMethod]
+//
+// Example:
+//
+//	Percentile({Prec: 2}, [1, 2, 3, 4], 25, MethodLinear) = 1.75
+//	Percentile({Prec: 2}, [1, 2, 3, 4], 25, MethodLower) = 1
+//	Percentile({Prec: 2}, [1, 2, 3, 4], 25, MethodExclusive) = 1.25
+func Percentile(ctx udecimal.Context, xs []udecimal.Decimal, p udecimal.Decimal, method Method) (udecimal.Decimal, error) {
+	if method > MethodExclusive {
+		return udecimal.Decimal{}, ErrInvalidMethod
+	}
+
+	if len(xs) == 0 {
+		return udecimal.Decimal{}, ErrEmpty
+	}
+
+	if p.IsNeg() || p.GreaterThan(hundred) {
+		return udecimal.Decimal{}, ErrInvalidPercentile
+	}
+
+	n := int64(len(xs))
+
+	// pos = 100 * h is exact, while h can have more than 19 digits after the decimal point
+	var pos udecimal.Decimal
+	if method == MethodExclusive {
+		pos = exact.Mul(udecimal.MustFromInt64(n+1, 0), p)
+		pos = pos.Sub(hundred)
+
+		if pos.IsNeg() || pos.GreaterThan(udecimal.MustFromInt64(100*(n-1), 0)) {
+			return udecimal.Decimal{}, fmt.Errorf("%w: %s is out of range for %d values with MethodExclusive", ErrInvalidPercentile, p, n)
+		}
+	} else {
+		pos = exact.Mul(udecimal.MustFromInt64(n-1, 0), p)
+	}
+
+	// pos = 100 * i + r with 0 <= r < 100, the divisor is never zero
+	q, r, _ := pos.QuoRem(hundred)
+	i64, _ := q.Int64()
+
+	//nolint:gosec // 0 <= i64 <= n - 1, so it's safe to convert to int
+	i := int(i64)
+
+	sorted := sortedCopy(xs)
+
+	if r.IsZero() {
+		return sorted[i], nil
+	}
+
+	// r > 0 means i < n - 1, so sorted[i+1] always exists
+	switch method {
+	case MethodLower:
+		return sorted[i], nil
+	case MethodHigher:
+		return sorted[i+1], nil
+	case MethodNearest:
+		if c := r.Cmp(fifty); c > 0 || (c == 0 && i%2 == 1) {
+			return sorted[i+1], nil
+		}
+
+		return sorted[i], nil
+	case MethodMidpoint:
+		return ctx.Div(sorted[i].Add(sorted[i+1]), two)
+	default:
+		// x[i] + r * (x[i+1] - x[i]) / 100, divided once so that the result is rounded only once
+		v := exact.Mul(r, sorted[i+1].Sub(sorted[i]))
+		x := exact.Mul(sorted[i], hundred)
+
+		return ctx.Div(x.Add(v), hundred)
+	}
+}
+
+// sortedCopy returns a sorted copy of xs
+func sortedCopy(xs []udecimal.Decimal) []udecimal.Decimal {
+	sorted := slices.Clone(xs)
+	slices.SortFunc(sorted, udecimal.Decimal.Cmp)
+
+	return sorted
+}
@@ -0,0 +1,159 @@
+package stats
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMedian(t *testing.T) {
+	testcases := []struct {
+		xs      string
+		ctx     udecimal.Context
+		want    string
+		wantErr error
+	}{
+		{"", udecimal.Context{Prec: 2}, "", ErrEmpty},
+		{"1.234", udecimal.Context{Prec: 2}, "1.234", nil},
+		{"3,1,2", udecimal.Context{Prec: 2}, "2", nil},
+		{"4,1,3,2", udecimal.Context{Prec: 2}, "2.5", nil},
+		{"4,1,3,2", udecimal.Context{Prec: 0}, "2", nil},
+		{"4,1,3,2", udecimal.Context{Prec: 0, Rounding: udecimal.RoundHalfEven}, "2", nil},
+		{"4,1,3,2", udecimal.Context{Prec: 0, Rounding: udecimal.RoundHalfUp}, "3", nil},
+		{"-0.5,0.25,-10,7.125", udecimal.Context{Prec: 19}, "-0.125", nil},
+		{"0.0000000000000000001,0", udecimal.Context{Prec: 19, Rounding: udecimal.RoundUp}, "0.0000000000000000001", nil},
+		{"340282366920938463463374607431768211455,1", udecimal.Context{Prec: 2}, "170141183460469231731687303715884105728", nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%+v", tc.xs, tc.ctx), func(t *testing.T) {
+			xs := parseAll(tc.xs)
+
+			got, err := Median(tc.ctx, xs)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+
+			// xs isn't modified
+			require.Equal(t, parseAll(tc.xs), xs)
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	testcases := []struct {
+		xs      string
+		p       string
+		method  Method
+		want    string
+		wantErr error
+	}{
+		// h = 1.6
+		{"40,15,50,35,20", "40", MethodLinear, "29", nil},
+		{"40,15,50,35,20", "40", MethodLower, "20", nil},
+		{"40,15,50,35,20", "40", MethodHigher, "35", nil},
+		{"40,15,50,35,20", "40", MethodNearest, "35", nil},
+		{"40,15,50,35,20", "40", MethodMidpoint, "27.5", nil},
+		{"40,15,50,35,20", "40", MethodExclusive, "26", nil},
+
+		// h = 1
+		{"40,15,50,35,20", "25", MethodLinear, "20", nil},
+		{"40,15,50,35,20", "25", MethodLower, "20", nil},
+		{"40,15,50,35,20", "25", MethodHigher, "20", nil},
+		{"40,15,50,35,20", "25", MethodNearest, "20", nil},
+		{"40,15,50,35,20", "25", MethodMidpoint, "20", nil},
+		{"40,15,50,35,20", "25", MethodExclusive, "17.5", nil},
+
+		{"40,15,50,35,20", "0", MethodLinear, "15", nil},
+		{"40,15,50,35,20", "0", MethodHigher, "15", nil},
+		{"40,15,50,35,20", "100", MethodLinear, "50", nil},
+		{"40,15,50,35,20", "100", MethodLower, "50", nil},
+		{"40,15,50,35,20", "50", MethodLinear, "35", nil},
+		{"7", "33", MethodLinear, "7", nil},
+		{"7", "50", MethodExclusive, "7", nil},
+
+		// f = 0.5, ties go to the even position
+		{"1,2,3,4", "50", MethodNearest, "3", nil},
+		{"1,2,3", "25", MethodNearest, "1", nil},
+		{"1,2,3", "75", MethodNearest, "3", nil},
+		{"1,2,3", "75.1", MethodNearest, "3", nil},
+		{"1,2,3", "24.9", MethodNearest, "1", nil},
+
+		// interpolated values are rounded once
+		{"1,2", "33.33", MethodLinear, "1.33", nil},
+		{"1,2", "33.335", MethodLinear, "1.33", nil},
+		{"1,2,3,4", "25", MethodLinear, "1.75", nil},
+		{"1,2,3,4", "25", MethodExclusive, "1.25", nil},
+		{"-2.5,-1,0.5", "10", MethodLinear, "-2.2", nil},
+		{"0.001,0.002", "50", MethodMidpoint, "0", nil},
+		{"0.0000000000000000001,0.0000000000000000002", "0.0000000000000000001", MethodLinear, "0", nil},
+
+		// p is not between 100 / (n + 1) and 100 * n / (n + 1) for MethodExclusive
+		{"40,15,50,35,20", "10", MethodExclusive, "", ErrInvalidPercentile},
+		{"40,15,50,35,20", "90", MethodExclusive, "", ErrInvalidPercentile},
+		{"7", "49", MethodExclusive, "", ErrInvalidPercentile},
+
+		{"1,2,3", "-0.1", MethodLinear, "", ErrInvalidPercentile},
+		{"1,2,3", "100.1", MethodLinear, "", ErrInvalidPercentile},
+		{"", "50", MethodLinear, "", ErrEmpty},
+		{"1,2,3", "50", MethodExclusive + 1, "", ErrInvalidMethod},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s/%d", tc.xs, tc.p, tc.method), func(t *testing.T) {
+			xs := parseAll(tc.xs)
+
+			got, err := Percentile(udecimal.Context{Prec: 2}, xs, udecimal.MustParse(tc.p), tc.method)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+
+			// xs isn't modified
+			require.Equal(t, parseAll(tc.xs), xs)
+		})
+	}
+}
+
+func TestPercentileRounding(t *testing.T) {
+	xs := parseAll("1,2")
+
+	// 1 + 0.125 * (2 - 1) = 1.125
+	p := udecimal.MustParse("12.5")
+
+	got, err := Percentile(udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}, xs, p, MethodLinear)
+	require.NoError(t, err)
+	require.Equal(t, "1.12", got.String())
+
+	got, err = Percentile(udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfUp}, xs, p, MethodLinear)
+	require.NoError(t, err)
+	require.Equal(t, "1.13", got.String())
+
+	got, err = Percentile(udecimal.Context{Prec: 19}, xs, p, MethodLinear)
+	require.NoError(t, err)
+	require.Equal(t, "1.125", got.String())
+}
+
+func TestPercentileMatchesMedian(t *testing.T) {
+	ctx := udecimal.Context{Prec: 19}
+	for _, s := range []string{"1", "2,1", "3,1,2", "1.5,-2.25,3.125,4", "0.1,0.2,0.3,0.4,0.5,100"} {
+		xs := parseAll(s)
+
+		median, err := Median(ctx, xs)
+		require.NoError(t, err)
+
+		p50, err := Percentile(ctx, xs, udecimal.MustParse("50"), MethodLinear)
+		require.NoError(t, err)
+
+		require.Equal(t, median.String(), p50.String(), s)
+	}
+}
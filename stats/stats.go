@@ -0,0 +1,291 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+	"github.com/quagmt/udecimal/internal/exact"
+)
+
+var (
+	// ErrEmpty is returned when a statistic is computed over an empty slice
+	ErrEmpty = fmt.Errorf("empty slice")
+
+	// ErrTooFewValues is returned when a sample statistic is computed over less than 2 values
+	ErrTooFewValues = fmt.Errorf("too few values. Sample statistics need at least 2 values")
+
+	// ErrLengthMismatch is returned when the values and the weights have different lengths
+	ErrLengthMismatch = fmt.Errorf("values and weights have different lengths")
+
+	// ErrInvalidWeights is returned when a weight is negative or all weights are zero
+	ErrInvalidWeights = fmt.Errorf("invalid weights. Must be non-negative and sum up to a positive number")
+
+	// ErrInvalidPercentile is returned when the percentile is not between 0 and 100,
+	// or is out of the range supported by the method
+	ErrInvalidPercentile = fmt.Errorf("invalid percentile. Must be between 0 and 100")
+
+	// ErrInvalidMethod is returned when the percentile method is not one of the defined methods
+	ErrInvalidMethod = fmt.Errorf("invalid percentile method")
+)
+
+// Sum returns the exact sum of xs, with as many digits after the decimal point as the most precise value.
+// The sum of an empty slice is 0.
+//
+// Example:
+//
+//	Sum([1.5, 2.25, -0.75]) = 3
+func Sum(xs []udecimal.Decimal) udecimal.Decimal {
+	acc := udecimal.NewAccumulator(maxPrec(xs))
+	for _, x := range xs {
+		acc.Add(x)
+	}
+
+	return acc.Result()
+}
+
+// Mean returns the arithmetic mean of xs, rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// Returns [ErrEmpty] if xs is empty.
+//
+// Example:
+//
+//	Mean({Prec: 2, Rounding: RoundHalfEven}, [1, 2, 2]) = 1.67
+func Mean(ctx udecimal.Context, xs []udecimal.Decimal) (udecimal.Decimal, error) {
+	if len(xs) == 0 {
+		return udecimal.Decimal{}, ErrEmpty
+	}
+
+	//nolint:gosec // len(xs) > 0, so it's safe to convert to uint64
+	return ctx.Div64(Sum(xs), uint64(len(xs)))
+}
+
+// WeightedMean returns sum(xs[i] * weights[i]) / sum(weights),
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// Returns error if:
+//   - xs is empty: [ErrEmpty]
+//   - xs and weights have different lengths: [ErrLengthMismatch]
+//   - a weight is negative or all weights are zero: [ErrInvalidWeights]
+//
+// Example:
+//
+//	WeightedMean({Prec: 2}, [10, 20], [3, 1]) = 12.5
+func WeightedMean(ctx udecimal.Context, xs, weights []udecimal.Decimal) (udecimal.Decimal, error) {
+	if len(xs) == 0 {
+		return udecimal.Decimal{}, ErrEmpty
+	}
+
+	if len(xs) != len(weights) {
+		return udecimal.Decimal{}, ErrLengthMismatch
+	}
+
+	for _, w := range weights {
+		if w.IsNeg() {
+			return udecimal.Decimal{}, ErrInvalidWeights
+		}
+	}
+
+	// sum(x * w) / sum(w) = sum(X * W) / (sum(W) * 10^xPrec), with the integers X = x * 10^xPrec and W = w * 10^wPrec
+	xPrec, wPrec := maxPrec(xs), maxPrec(weights)
+
+	products, total := udecimal.NewAccumulator(0), udecimal.NewAccumulator(0)
+	for i, x := range xs {
+		w := exact.Integer(weights[i], wPrec)
+
+		products.AddProduct(exact.Integer(x, xPrec), w)
+		total.Add(w)
+	}
+
+	if total.Result().IsZero() {
+		return udecimal.Decimal{}, ErrInvalidWeights
+	}
+
+	den := exact.Mul(total.Result(), exact.Pow10(xPrec))
+	return ctx.Div(products.Result(), den)
+}
+
+// Variance returns the population variance of xs, sum((x - mean)^2) / n,
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// Returns [ErrEmpty] if xs is empty.
+//
+// Example:
+//
+//	Variance({Prec: 2}, [2, 4, 4, 4, 5, 5, 7, 9]) = 4
+func Variance(ctx udecimal.Context, xs []udecimal.Decimal) (udecimal.Decimal, error) {
+	if len(xs) == 0 {
+		return udecimal.Decimal{}, ErrEmpty
+	}
+
+	num, den := populationVariance(xs)
+	return ctx.Div(num, den)
+}
+
+// SampleVariance returns the sample variance of xs, sum((x - mean)^2) / (n - 1),
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// Returns error if xs is empty ([ErrEmpty]) or has only 1 value ([ErrTooFewValues]).
+//
+// Example:
+//
+//	SampleVariance({Prec: 2, Rounding: RoundHalfEven}, [2, 4, 4, 4, 5, 5, 7, 9]) = 4.57
+func SampleVariance(ctx udecimal.Context, xs []udecimal.Decimal) (udecimal.Decimal, error) {
+	num, den, err := sampleVariance(xs)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return ctx.Div(num, den)
+}
+
+// StdDev returns the population standard deviation of xs, the square root of [Variance],
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// The square root is computed from the exact variance, so the result is rounded only once.
+//
+// Returns [ErrEmpty] if xs is empty.
+//
+// Example:
+//
+//	StdDev({Prec: 2}, [2, 4, 4, 4, 5, 5, 7, 9]) = 2
+func StdDev(ctx udecimal.Context, xs []udecimal.Decimal) (udecimal.Decimal, error) {
+	if len(xs) == 0 {
+		return udecimal.Decimal{}, ErrEmpty
+	}
+
+	num, den := populationVariance(xs)
+	return sqrt(ctx, num, den)
+}
+
+// SampleStdDev returns the sample standard deviation of xs, the square root of [SampleVariance],
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// The square root is computed from the exact variance, so the result is rounded only once.
+//
+// Returns error if xs is empty ([ErrEmpty]) or has only 1 value ([ErrTooFewValues]).
+//
+// Example:
+//
+//	SampleStdDev({Prec: 2, Rounding: RoundHalfEven}, [2, 4, 4, 4, 5, 5, 7, 9]) = 2.14
+func SampleStdDev(ctx udecimal.Context, xs []udecimal.Decimal) (udecimal.Decimal, error) {
+	num, den, err := sampleVariance(xs)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	return sqrt(ctx, num, den)
+}
+
+// populationVariance returns the exact population variance of xs as num / den, with integers num and den.
+// xs must not be empty.
+func populationVariance(xs []udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal) {
+	num, scale := sumSquaredDev(xs)
+
+	n := udecimal.MustFromInt64(int64(len(xs)), 0)
+	den := exact.Mul(scale, n)
+	den = exact.Mul(den, n)
+
+	return num, den
+}
+
+// sampleVariance returns the exact sample variance of xs as num / den, with integers num and den
+func sampleVariance(xs []udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal, error) {
+	switch len(xs) {
+	case 0:
+		return udecimal.Decimal{}, udecimal.Decimal{}, ErrEmpty
+	case 1:
+		return udecimal.Decimal{}, udecimal.Decimal{}, ErrTooFewValues
+	}
+
+	num, scale := sumSquaredDev(xs)
+
+	n := udecimal.MustFromInt64(int64(len(xs)), 0)
+	den := exact.Mul(scale, n)
+	den = exact.Mul(den, n.Sub(udecimal.One))
+
+	return num, den, nil
+}
+
+// sumSquaredDev returns n^2 times the population variance of xs, n * sum(x^2) - sum(x)^2, as num / scale.
+// The sums are computed on the integers X = x * 10^prec, where prec is the largest precision of xs,
+// so num = n * sum(X^2) - sum(X)^2 and scale = 10^(2*prec) are exact integers, whatever the number of digits.
+func sumSquaredDev(xs []udecimal.Decimal) (udecimal.Decimal, udecimal.Decimal) {
+	prec := maxPrec(xs)
+
+	sumSq, sum := udecimal.NewAccumulator(0), udecimal.NewAccumulator(0)
+	for _, x := range xs {
+		v := exact.Integer(x, prec)
+
+		sumSq.AddProduct(v, v)
+		sum.Add(v)
+	}
+
+	n := udecimal.MustFromInt64(int64(len(xs)), 0)
+	nSumSq := exact.Mul(n, sumSq.Result())
+	sq := exact.Mul(sum.Result(), sum.Result())
+	scale := exact.Pow10(prec)
+	scaleSq := exact.Mul(scale, scale)
+
+	return nSumSq.Sub(sq), scaleSq
+}
+
+// sqrt returns the square root of num / den, with integers num >= 0 and den > 0,
+// rounded to ctx.Prec digits after the decimal point using ctx.Rounding.
+//
+// With a = num * 10^(2*prec), the result is sqrt(a / den) / 10^prec. k = floor(sqrt(a / den))
+// and the position of sqrt(a / den) between k and k + 1 are found with integers only,
+// then (4k + c) / (4 * 10^prec) is rounded by ctx.Div, where c is 0 if sqrt(a / den) = k,
+// 1 if it's below k + 0.5, 2 if it's k + 0.5 and 3 if it's above.
+func sqrt(ctx udecimal.Context, num, den udecimal.Decimal) (udecimal.Decimal, error) {
+	prec := min(ctx.Prec, 19)
+	scale := exact.Pow10(prec)
+
+	a := exact.Mul(num, scale)
+	a = exact.Mul(a, scale)
+
+	// floor(sqrt(a / den)) = floor(floor(sqrt(a * den)) / den),
+	// and floor(sqrt(a * den)) is the square root of the integer a * den truncated to 0 digits
+	ad := exact.Mul(a, den)
+
+	s, err := udecimal.Context{}.Sqrt(ad)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	k, _, err := s.QuoRem(den)
+	if err != nil {
+		return udecimal.Decimal{}, err
+	}
+
+	four := udecimal.MustFromInt64(4, 0)
+	fourK := exact.Mul(four, k)
+
+	// compare a / den with k^2 and (k + 0.5)^2, i.e. 4a with 4k^2 * den and (2k + 1)^2 * den
+	kk := exact.Mul(k, k)
+	kk = exact.Mul(kk, den)
+
+	twoK1 := k.Add(k).Add(udecimal.One)
+	half := exact.Mul(twoK1, twoK1)
+	half = exact.Mul(half, den)
+
+	fourA := exact.Mul(four, a)
+
+	var c int64
+	if !a.Equal(kk) {
+		c = 2 + int64(fourA.Cmp(half))
+	}
+
+	den = exact.Mul(four, scale)
+	return ctx.Div(fourK.Add(udecimal.MustFromInt64(c, 0)), den)
+}
+
+// maxPrec returns the largest number of digits after the decimal point of xs
+func maxPrec(xs []udecimal.Decimal) uint8 {
+	var prec int
+	for _, x := range xs {
+		prec = max(prec, x.Prec())
+	}
+
+	//nolint:gosec // the precision of a decimal is at most 19, so it's safe to convert to uint8
+	return uint8(prec)
+}
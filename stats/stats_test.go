@@ -0,0 +1,298 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/require"
+)
+
+func parseAll(s string) []udecimal.Decimal {
+	if s == "" {
+		return nil
+	}
+
+	fields := strings.Split(s, ",")
+	xs := make([]udecimal.Decimal, len(fields))
+	for i, f := range fields {
+		xs[i] = udecimal.MustParse(f)
+	}
+
+	return xs
+}
+
+func TestSum(t *testing.T) {
+	testcases := []struct {
+		xs   string
+		want string
+	}{
+		{"", "0"},
+		{"1.5", "1.5"},
+		{"1.5,2.25,-0.75", "3"},
+		{"0.1,0.1,0.1,0.1,0.1,0.1,0.1,0.1,0.1,0.1", "1"},
+		{"0.0000000000000000001,1000000000000000000", "1000000000000000000.0000000000000000001"},
+		{"-123.456,123.456", "0"},
+		{"340282366920938463463374607431768211455,340282366920938463463374607431768211455", "680564733841876926926749214863536422910"},
+		{"123456789012345678901234567890.123456789,-0.000000001", "123456789012345678901234567890.123456788"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.xs, func(t *testing.T) {
+			require.Equal(t, tc.want, Sum(parseAll(tc.xs)).String())
+		})
+	}
+}
+
+func TestMean(t *testing.T) {
+	testcases := []struct {
+		xs      string
+		ctx     udecimal.Context
+		want    string
+		wantErr error
+	}{
+		{"", udecimal.Context{Prec: 2}, "", ErrEmpty},
+		{"1.5", udecimal.Context{Prec: 2}, "1.5", nil},
+		{"0.1,0.2", udecimal.Context{Prec: 19}, "0.15", nil},
+		{"1,2,2", udecimal.Context{Prec: 2}, "1.66", nil},
+		{"1,2,2", udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}, "1.67", nil},
+		{"1,2,2", udecimal.Context{Prec: 19}, "1.6666666666666666666", nil},
+		{"-1,-2,-2", udecimal.Context{Prec: 0, Rounding: udecimal.RoundHalfUp}, "-2", nil},
+		{"0.0000000000000000001,0.0000000000000000002", udecimal.Context{Prec: 19, Rounding: udecimal.RoundHalfEven}, "0.0000000000000000002", nil},
+		{"340282366920938463463374607431768211455,340282366920938463463374607431768211455", udecimal.Context{Prec: 2}, "340282366920938463463374607431768211455", nil},
+		{"1,2", udecimal.Context{Prec: 2, Rounding: 100}, "", udecimal.ErrInvalidRoundingMode},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%+v", tc.xs, tc.ctx), func(t *testing.T) {
+			got, err := Mean(tc.ctx, parseAll(tc.xs))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	testcases := []struct {
+		xs, weights string
+		ctx         udecimal.Context
+		want        string
+		wantErr     error
+	}{
+		{"", "", udecimal.Context{Prec: 2}, "", ErrEmpty},
+		{"10,20", "3,1", udecimal.Context{Prec: 2}, "12.5", nil},
+		{"10,20", "0.75,0.25", udecimal.Context{Prec: 2}, "12.5", nil},
+		{"1.25,2.5,4", "1,1,1", udecimal.Context{Prec: 19}, "2.5833333333333333333", nil},
+		{"1.25,2.5,4", "1,1,1", udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven}, "2.58", nil},
+		{"100,200,300", "0,0,1", udecimal.Context{Prec: 2}, "300", nil},
+		{"0.123456789,0.987654321", "0.5,0.5", udecimal.Context{Prec: 19}, "0.555555555", nil},
+		{"0.0000000001,0.0000000003", "0.0000000001,0.0000000001", udecimal.Context{Prec: 19}, "0.0000000002", nil},
+		{"1.0000000001,2", "0.00000000001,0.00000000003", udecimal.Context{Prec: 19}, "1.750000000025", nil},
+		{"10,20", "1", udecimal.Context{Prec: 2}, "", ErrLengthMismatch},
+		{"10,20", "0,0", udecimal.Context{Prec: 2}, "", ErrInvalidWeights},
+		{"10,20", "2,-1", udecimal.Context{Prec: 2}, "", ErrInvalidWeights},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%s", tc.xs, tc.weights), func(t *testing.T) {
+			got, err := WeightedMean(tc.ctx, parseAll(tc.xs), parseAll(tc.weights))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestVariance(t *testing.T) {
+	testcases := []struct {
+		xs                   string
+		ctx                  udecimal.Context
+		variance, sampleVar  string
+		stdDev, sampleStdDev string
+		wantErr              error
+		wantSampleErr        error
+	}{
+		{
+			xs:      "",
+			ctx:     udecimal.Context{Prec: 2},
+			wantErr: ErrEmpty, wantSampleErr: ErrEmpty,
+		},
+		{
+			xs:            "1.5",
+			ctx:           udecimal.Context{Prec: 2},
+			variance:      "0",
+			stdDev:        "0",
+			wantSampleErr: ErrTooFewValues,
+		},
+		{
+			xs:           "2,4,4,4,5,5,7,9",
+			ctx:          udecimal.Context{Prec: 19},
+			variance:     "4",
+			sampleVar:    "4.5714285714285714285",
+			stdDev:       "2",
+			sampleStdDev: "2.1380899352993950774",
+		},
+		{
+			xs:           "2,4,4,4,5,5,7,9",
+			ctx:          udecimal.Context{Prec: 2, Rounding: udecimal.RoundHalfEven},
+			variance:     "4",
+			sampleVar:    "4.57",
+			stdDev:       "2",
+			sampleStdDev: "2.14",
+		},
+		{
+			// the mean 0.35 can't be used as is for the deviations of the sample
+			xs:           "0.1,0.2,0.4,0.7",
+			ctx:          udecimal.Context{Prec: 4, Rounding: udecimal.RoundHalfUp},
+			variance:     "0.0525",
+			sampleVar:    "0.07",
+			stdDev:       "0.2291",
+			sampleStdDev: "0.2646",
+		},
+		{
+			xs:           "-1.5,1.5",
+			ctx:          udecimal.Context{Prec: 3},
+			variance:     "2.25",
+			sampleVar:    "4.5",
+			stdDev:       "1.5",
+			sampleStdDev: "2.121",
+		},
+		{
+			// the squares have more than 19 digits after the decimal point
+			xs:           "0.1234567891,0.1234567891,0.1234567891",
+			ctx:          udecimal.Context{Prec: 19},
+			variance:     "0",
+			sampleVar:    "0",
+			stdDev:       "0",
+			sampleStdDev: "0",
+		},
+		{
+			// the variance 2.25e-22 has more than 19 digits after the decimal point, but its square root doesn't
+			xs:           "0,0.00000000003",
+			ctx:          udecimal.Context{Prec: 19, Rounding: udecimal.RoundHalfUp},
+			variance:     "0",
+			sampleVar:    "0",
+			stdDev:       "0.000000000015",
+			sampleStdDev: "0.0000000000212132034",
+		},
+		{
+			// the squares have 20 digits after the decimal point and more than 38 digits in total
+			xs:           "123456789.0123456789,123456789.0123456788,-0.0000000001",
+			ctx:          udecimal.Context{Prec: 19, Rounding: udecimal.RoundHalfUp},
+			variance:     "3387017500719741.5027858219752747718",
+			sampleVar:    "5080526251079612.2541787329629121577",
+			stdDev:       "58198088.4627643204415496064",
+			sampleStdDev: "71277810.3695646100630808659",
+		},
+		{
+			// sum of squares doesn't fit in 128 bits
+			xs:           "1000000000000000000.5,3000000000000000000.5",
+			ctx:          udecimal.Context{Prec: 2},
+			variance:     "1000000000000000000000000000000000000",
+			sampleVar:    "2000000000000000000000000000000000000",
+			stdDev:       "1000000000000000000",
+			sampleStdDev: "1414213562373095048.8",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s/%+v", tc.xs, tc.ctx), func(t *testing.T) {
+			xs := parseAll(tc.xs)
+
+			variance, err := Variance(tc.ctx, xs)
+			stdDev, err2 := StdDev(tc.ctx, xs)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.ErrorIs(t, err2, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, err2)
+				require.Equal(t, tc.variance, variance.String())
+				require.Equal(t, tc.stdDev, stdDev.String())
+			}
+
+			sampleVar, err := SampleVariance(tc.ctx, xs)
+			sampleStdDev, err2 := SampleStdDev(tc.ctx, xs)
+			if tc.wantSampleErr != nil {
+				require.ErrorIs(t, err, tc.wantSampleErr)
+				require.ErrorIs(t, err2, tc.wantSampleErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, err2)
+			require.Equal(t, tc.sampleVar, sampleVar.String())
+			require.Equal(t, tc.sampleStdDev, sampleStdDev.String())
+		})
+	}
+}
+
+func TestStdDevRounding(t *testing.T) {
+	xs := parseAll("1,2")
+
+	// sqrt(0.25) = 0.5
+	got, err := StdDev(udecimal.Context{Prec: 0, Rounding: udecimal.RoundHalfUp}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "1", got.String())
+
+	got, err = StdDev(udecimal.Context{Prec: 0, Rounding: udecimal.RoundHalfEven}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "0", got.String())
+
+	// sqrt(2.25e-22) = 0.000000000015 is a tie at 11 digits, even though the variance has 22 digits
+	xs = parseAll("0,0.00000000003")
+
+	got, err = StdDev(udecimal.Context{Prec: 11, Rounding: udecimal.RoundHalfUp}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "0.00000000002", got.String())
+
+	got, err = StdDev(udecimal.Context{Prec: 11, Rounding: udecimal.RoundHalfDown}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "0.00000000001", got.String())
+
+	got, err = StdDev(udecimal.Context{Prec: 11, Rounding: udecimal.RoundUp}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "0.00000000002", got.String())
+
+	// the result is exact or not, whatever the precision of the variance
+	got, err = StdDev(udecimal.Context{Prec: 12, Rounding: udecimal.RoundUnnecessary}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "0.000000000015", got.String())
+
+	_, err = SampleStdDev(udecimal.Context{Prec: 19, Rounding: udecimal.RoundUnnecessary}, xs)
+	require.ErrorIs(t, err, udecimal.ErrRoundingNecessary)
+
+	_, err = StdDev(udecimal.Context{Prec: 0, Rounding: 100}, xs)
+	require.ErrorIs(t, err, udecimal.ErrInvalidRoundingMode)
+}
+
+func TestStdDevLargeValues(t *testing.T) {
+	defer udecimal.SetDefaultParseMode(udecimal.ParseModeError)
+	defer udecimal.SetDefaultPrecision(19)
+
+	xs := parseAll("0,1" + strings.Repeat("0", 150) + ".0000000000000000001")
+
+	// the square root is computed on integers with more than 200 digits,
+	// and doesn't depend on the default precision and parse mode
+	udecimal.SetDefaultPrecision(2)
+	udecimal.SetDefaultParseMode(udecimal.ParseModeTrunc)
+
+	// (1e150 + 1e-19) / 2 is a tie at 19 digits
+	got, err := StdDev(udecimal.Context{Prec: 19, Rounding: udecimal.RoundHalfUp}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "5"+strings.Repeat("0", 149)+".0000000000000000001", got.String())
+
+	got, err = StdDev(udecimal.Context{Prec: 19, Rounding: udecimal.RoundHalfEven}, xs)
+	require.NoError(t, err)
+	require.Equal(t, "5"+strings.Repeat("0", 149), got.String())
+}
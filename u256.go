@@ -1,6 +1,9 @@
 package udecimal
 
 import (
+	"encoding/binary"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
@@ -104,6 +107,68 @@ func (u u256) pow(e int) (u256, error) {
 	return result, nil
 }
 
+// isZero returns true if u is zero
+func (u u256) isZero() bool {
+	return u == u256{}
+}
+
+// cmp compares u and v, returns:
+//
+//	+1 when u > v
+//	 0 when u = v
+//	-1 when u < v
+func (u u256) cmp(v u256) int {
+	if c := u.carry.Cmp(v.carry); c != 0 {
+		return c
+	}
+
+	return u128FromHiLo(u.hi, u.lo).Cmp(u128FromHiLo(v.hi, v.lo))
+}
+
+// add returns u+v. Returns errOverflow if the result doesn't fit in 256 bits
+func (u u256) add(v u256) (u256, error) {
+	lo, carry := bits.Add64(u.lo, v.lo, 0)
+	hi, carry := bits.Add64(u.hi, v.hi, carry)
+	clo, carry := bits.Add64(u.carry.lo, v.carry.lo, carry)
+	chi, carry := bits.Add64(u.carry.hi, v.carry.hi, carry)
+	if carry != 0 {
+		return u256{}, errOverflow
+	}
+
+	return u256{hi: hi, lo: lo, carry: u128{hi: chi, lo: clo}}, nil
+}
+
+// sub returns u-v with u >= v
+// must be called only when u >= v or the result will be incorrect
+func (u u256) sub(v u256) u256 {
+	lo, borrow := bits.Sub64(u.lo, v.lo, 0)
+	hi, borrow := bits.Sub64(u.hi, v.hi, borrow)
+	clo, borrow := bits.Sub64(u.carry.lo, v.carry.lo, borrow)
+	chi, _ := bits.Sub64(u.carry.hi, v.carry.hi, borrow)
+
+	return u256{hi: hi, lo: lo, carry: u128{hi: chi, lo: clo}}
+}
+
+// quoRem64 returns quotient and remainder of u/v
+func (u u256) quoRem64(v uint64) (u256, uint64) {
+	chi, r := bits.Div64(0, u.carry.hi, v)
+	clo, r := bits.Div64(r, u.carry.lo, v)
+	hi, r := bits.Div64(r, u.hi, v)
+	lo, r := bits.Div64(r, u.lo, v)
+
+	return u256{hi: hi, lo: lo, carry: u128{hi: chi, lo: clo}}, r
+}
+
+func (u u256) toBigInt() *big.Int {
+	var bytes [32]byte
+	binary.BigEndian.PutUint64(bytes[:], u.carry.hi)
+	binary.BigEndian.PutUint64(bytes[8:], u.carry.lo)
+	binary.BigEndian.PutUint64(bytes[16:], u.hi)
+	binary.BigEndian.PutUint64(bytes[24:], u.lo)
+
+	return new(big.Int).SetBytes(bytes[:])
+}
+
 func (u u256) mul128(v u128) (u256, error) {
 	a := u128FromHiLo(u.hi, u.lo).MulToU256(v)
 	b, err := u.carry.Mul(v)
@@ -178,7 +243,7 @@ func (u u256) div256by128(v u128) (u128, u128) {
 
 	// q = a / v
 	aLen := 3
-	if a[3] != 0 || (a[3] == 0 && a[2] > v.hi) {
+	if a[3] != 0 || (a[3] == 0 && a[2] >= v.hi) {
 		aLen = 4
 	}
 
@@ -189,15 +254,29 @@ func (u u256) div256by128(v u128) (u128, u128) {
 
 		// trial quotient tq = [u2,u1,u0] / v ~= [u2,u1] / v.hi
 		// tq <= q + 2
-		tq, r := bits.Div64(u2, u1, v.hi)
+		var tq, r, rCarry uint64
+
+		if u2 < v.hi {
+			tq, r = bits.Div64(u2, u1, v.hi)
+		} else {
+			// u2 == v.hi because [u2,u1] < v, so [u2,u1] / v.hi doesn't fit in uint64 (bits.Div64 panics).
+			// Use tq = 2^64 - 1 instead, which is still >= q,
+			// and r = [u2,u1] - tq*v.hi = u1 + v.hi, which can exceed 64 bits
+			tq = math.MaxUint64
+			r, rCarry = bits.Add64(u1, v.hi, 0)
+		}
 
 		c1h, c1l := bits.Mul64(tq, v.lo)
 		c1 := u128{hi: c1h, lo: c1l}
+
+		// if rCarry != 0, c2 = [1,r,u0] >= 2^128 > c1 and is truncated to u128 here.
+		// That's fine because rem = c2 - c1 < v fits in u128, so the subtraction below
+		// still gives the correct result
 		c2 := u128{hi: r, lo: u0}
 
 		// adjust tq
 		var k uint64
-		if c1.Cmp(c2) > 0 {
+		if rCarry == 0 && c1.Cmp(c2) > 0 {
 			k = 1
 
 			// d = c1 - c2
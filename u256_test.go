@@ -2,6 +2,8 @@ package udecimal
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,3 +43,131 @@ func TestBitlen(t *testing.T) {
 		})
 	}
 }
+
+func TestDiv256by128(t *testing.T) {
+	// u.carry < v and v.hi != 0, as guaranteed by fastQuo
+	testcases := []struct {
+		u u256
+		v u128
+	}{
+		{
+			// u.carry.hi == v.hi: the top word equals the divisor's top word after normalization
+			u: u256{hi: 0, lo: 1, carry: u128{hi: 1 << 63, lo: 0}},
+			v: u128{hi: 1 << 63, lo: 1},
+		},
+		{
+			u: u256{hi: math.MaxUint64, lo: math.MaxUint64, carry: u128{hi: 0x23, lo: math.MaxUint64 - 1}},
+			v: u128{hi: 0x23, lo: math.MaxUint64},
+		},
+		{
+			// 1199038364791120855235 * 10^19 / 650000000000000000078
+			u: u128{hi: 65, lo: 195}.MulToU256(pow10[19]),
+			v: u128{hi: 0x23, lo: 0x3c8fe42703e8004e},
+		},
+		{
+			u: u256{hi: 123, lo: 456, carry: u128{hi: 1, lo: 789}},
+			v: u128{hi: 2, lo: 0},
+		},
+		{
+			u: u256{hi: math.MaxUint64, lo: math.MaxUint64, carry: u128{hi: math.MaxUint64, lo: math.MaxUint64 - 1}},
+			v: u128{hi: math.MaxUint64, lo: math.MaxUint64},
+		},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			q, r := tc.u.div256by128(tc.v)
+
+			uBig := tc.u.carry.ToBigInt()
+			uBig.Lsh(uBig, 128)
+			uBig.Add(uBig, u128{hi: tc.u.hi, lo: tc.u.lo}.ToBigInt())
+
+			wantQ, wantR := new(big.Int).QuoRem(uBig, tc.v.ToBigInt(), new(big.Int))
+			require.Equal(t, wantQ, q.ToBigInt())
+			require.Equal(t, wantR, r.ToBigInt())
+		})
+	}
+}
+
+func TestU256AddSub(t *testing.T) {
+	max256 := u256{hi: math.MaxUint64, lo: math.MaxUint64, carry: max128}
+
+	testcases := []struct {
+		u, v    u256
+		wantErr error
+	}{
+		{u: u256{}, v: u256{}},
+		{u: u256{lo: math.MaxUint64}, v: u256{lo: 1}},
+		{u: u256{hi: math.MaxUint64, lo: math.MaxUint64}, v: u256{lo: 1}},
+		{u: u256{hi: math.MaxUint64, lo: math.MaxUint64, carry: u128{lo: math.MaxUint64}}, v: u256{lo: 1}},
+		{u: u256{hi: 123, lo: 456, carry: u128{hi: 789}}, v: u256{hi: math.MaxUint64, lo: 1, carry: u128{lo: 1}}},
+		{u: max256, v: u256{}},
+		{u: max256, v: u256{lo: 1}, wantErr: errOverflow},
+		{u: u256{carry: u128{hi: 1 << 63}}, v: u256{carry: u128{hi: 1 << 63}}, wantErr: errOverflow},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got, err := tc.u.add(tc.v)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			want := new(big.Int).Add(tc.u.toBigInt(), tc.v.toBigInt())
+			require.Equal(t, want.String(), got.toBigInt().String())
+			require.Equal(t, want.Cmp(tc.u.toBigInt()), got.cmp(tc.u))
+
+			// (u + v) - v = u
+			require.Equal(t, tc.u, got.sub(tc.v))
+			require.Equal(t, tc.v, got.sub(tc.u))
+		})
+	}
+}
+
+func TestU256Cmp(t *testing.T) {
+	testcases := []struct {
+		u, v u256
+		want int
+	}{
+		{u256{}, u256{}, 0},
+		{u256{lo: 1}, u256{}, 1},
+		{u256{}, u256{lo: 1}, -1},
+		{u256{hi: 1}, u256{lo: math.MaxUint64}, 1},
+		{u256{carry: u128{lo: 1}}, u256{hi: math.MaxUint64, lo: math.MaxUint64}, 1},
+		{u256{carry: u128{hi: 1}}, u256{carry: u128{lo: math.MaxUint64}, hi: math.MaxUint64}, 1},
+		{u256{hi: 5, carry: u128{lo: 1}}, u256{hi: 6, carry: u128{lo: 1}}, -1},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			require.Equal(t, tc.want, tc.u.cmp(tc.v))
+			require.Equal(t, tc.want, tc.u.toBigInt().Cmp(tc.v.toBigInt()))
+		})
+	}
+}
+
+func TestU256QuoRem64(t *testing.T) {
+	testcases := []struct {
+		u u256
+		v uint64
+	}{
+		{u256{}, 1},
+		{u256{lo: 123}, 10},
+		{u256{hi: math.MaxUint64, lo: math.MaxUint64, carry: max128}, 1e19},
+		{u256{hi: 123, lo: 456, carry: u128{hi: 789, lo: 1011}}, 7},
+		{u256{hi: 1, carry: u128{hi: 1}}, math.MaxUint64},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			q, r := tc.u.quoRem64(tc.v)
+
+			wantQ, wantR := new(big.Int).QuoRem(tc.u.toBigInt(), new(big.Int).SetUint64(tc.v), new(big.Int))
+			require.Equal(t, wantQ.String(), q.toBigInt().String())
+			require.Equal(t, wantR.Uint64(), r)
+		})
+	}
+}